@@ -1,57 +1,62 @@
 module github.com/hashicorp/terraform-provider-aws
 
-go 1.20
+go 1.22
 
 require (
 	github.com/ProtonMail/go-crypto v0.0.0-20230217124315-7d5c6f04bbb8
 	github.com/aws/aws-sdk-go v1.44.292
-	github.com/aws/aws-sdk-go-v2 v1.18.1
-	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.13.4
-	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.19.14
-	github.com/aws/aws-sdk-go-v2/service/account v1.10.8
-	github.com/aws/aws-sdk-go-v2/service/acm v1.17.13
-	github.com/aws/aws-sdk-go-v2/service/appconfig v1.17.11
-	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.25.0
-	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.1.6
-	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.11.14
-	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.21.2
-	github.com/aws/aws-sdk-go-v2/service/comprehend v1.24.4
-	github.com/aws/aws-sdk-go-v2/service/computeoptimizer v1.24.2
-	github.com/aws/aws-sdk-go-v2/service/directoryservice v1.17.3
-	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.1.12
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.102.0
-	github.com/aws/aws-sdk-go-v2/service/finspace v1.10.2
-	github.com/aws/aws-sdk-go-v2/service/fis v1.14.12
-	github.com/aws/aws-sdk-go-v2/service/glacier v1.14.13
-	github.com/aws/aws-sdk-go-v2/service/healthlake v1.16.2
-	github.com/aws/aws-sdk-go-v2/service/identitystore v1.16.13
-	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.15.0
-	github.com/aws/aws-sdk-go-v2/service/ivschat v1.4.7
-	github.com/aws/aws-sdk-go-v2/service/kendra v1.41.0
-	github.com/aws/aws-sdk-go-v2/service/lambda v1.37.0
-	github.com/aws/aws-sdk-go-v2/service/lightsail v1.27.1
-	github.com/aws/aws-sdk-go-v2/service/medialive v1.31.6
-	github.com/aws/aws-sdk-go-v2/service/oam v1.1.13
-	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.2.6
-	github.com/aws/aws-sdk-go-v2/service/pipes v1.2.8
-	github.com/aws/aws-sdk-go-v2/service/pricing v1.20.0
-	github.com/aws/aws-sdk-go-v2/service/rbin v1.8.14
-	github.com/aws/aws-sdk-go-v2/service/rds v1.46.0
-	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.2.15
-	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.2.2
-	github.com/aws/aws-sdk-go-v2/service/route53domains v1.15.0
-	github.com/aws/aws-sdk-go-v2/service/s3control v1.31.7
-	github.com/aws/aws-sdk-go-v2/service/scheduler v1.1.13
-	github.com/aws/aws-sdk-go-v2/service/securitylake v1.4.3
-	github.com/aws/aws-sdk-go-v2/service/sesv2 v1.18.2
-	github.com/aws/aws-sdk-go-v2/service/ssm v1.36.7
-	github.com/aws/aws-sdk-go-v2/service/ssmcontacts v1.15.6
-	github.com/aws/aws-sdk-go-v2/service/ssmincidents v1.21.6
-	github.com/aws/aws-sdk-go-v2/service/swf v1.15.2
-	github.com/aws/aws-sdk-go-v2/service/transcribe v1.26.8
-	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.0.7
-	github.com/aws/aws-sdk-go-v2/service/xray v1.16.13
-	github.com/aws/smithy-go v1.13.5
+	github.com/aws/aws-sdk-go-v2 v1.36.3
+	github.com/aws/aws-sdk-go-v2/credentials v1.17.61
+	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.30
+	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.17.33
+	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.38.0
+	github.com/aws/aws-sdk-go-v2/service/account v1.23.0
+	github.com/aws/aws-sdk-go-v2/service/acm v1.31.0
+	github.com/aws/aws-sdk-go-v2/service/appconfig v1.37.0
+	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.38.0
+	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.22.0
+	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.24.0
+	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.46.0
+	github.com/aws/aws-sdk-go-v2/service/comprehend v1.36.0
+	github.com/aws/aws-sdk-go-v2/service/computeoptimizer v1.42.0
+	github.com/aws/aws-sdk-go-v2/service/directoryservice v1.31.0
+	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.15.0
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.211.0
+	github.com/aws/aws-sdk-go-v2/service/eks v1.51.0
+	github.com/aws/aws-sdk-go-v2/service/finspace v1.29.0
+	github.com/aws/aws-sdk-go-v2/service/fis v1.33.0
+	github.com/aws/aws-sdk-go-v2/service/glacier v1.27.0
+	github.com/aws/aws-sdk-go-v2/service/healthlake v1.30.0
+	github.com/aws/aws-sdk-go-v2/service/identitystore v1.28.0
+	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.35.0
+	github.com/aws/aws-sdk-go-v2/service/ivschat v1.17.0
+	github.com/aws/aws-sdk-go-v2/service/kendra v1.56.0
+	github.com/aws/aws-sdk-go-v2/service/lambda v1.64.0
+	github.com/aws/aws-sdk-go-v2/service/lightsail v1.43.0
+	github.com/aws/aws-sdk-go-v2/service/medialive v1.37.0
+	github.com/aws/aws-sdk-go-v2/service/oam v1.17.0
+	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.19.0
+	github.com/aws/aws-sdk-go-v2/service/pipes v1.19.0
+	github.com/aws/aws-sdk-go-v2/service/pricing v1.33.0
+	github.com/aws/aws-sdk-go-v2/service/rbin v1.22.0
+	github.com/aws/aws-sdk-go-v2/service/rds v1.94.0
+	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.17.0
+	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.17.0
+	github.com/aws/aws-sdk-go-v2/service/route53domains v1.29.0
+	github.com/aws/aws-sdk-go-v2/service/s3 v1.66.0
+	github.com/aws/aws-sdk-go-v2/service/s3control v1.49.2
+	github.com/aws/aws-sdk-go-v2/service/scheduler v1.13.0
+	github.com/aws/aws-sdk-go-v2/service/securitylake v1.20.0
+	github.com/aws/aws-sdk-go-v2/service/sesv2 v1.43.0
+	github.com/aws/aws-sdk-go-v2/service/ssm v1.57.0
+	github.com/aws/aws-sdk-go-v2/service/ssmcontacts v1.27.0
+	github.com/aws/aws-sdk-go-v2/service/ssmincidents v1.35.0
+	github.com/aws/aws-sdk-go-v2/service/sts v1.33.16
+	github.com/aws/aws-sdk-go-v2/service/swf v1.28.0
+	github.com/aws/aws-sdk-go-v2/service/transcribe v1.44.0
+	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.14.0
+	github.com/aws/aws-sdk-go-v2/service/xray v1.31.0
+	github.com/aws/smithy-go v1.22.2
 	github.com/beevik/etree v1.2.0
 	github.com/google/go-cmp v0.5.9
 	github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go v0.21.0
@@ -96,18 +101,19 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v13 v13.0.0 // indirect
 	github.com/armon/go-radix v0.0.0-20180808171621-7fddfc383310 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.4.10 // indirect
-	github.com/aws/aws-sdk-go-v2/config v1.18.25 // indirect
-	github.com/aws/aws-sdk-go-v2/credentials v1.13.24 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.1.34 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.4.28 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/ini v1.3.34 // indirect
-	github.com/aws/aws-sdk-go-v2/service/iam v1.20.2 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.9.28 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.14.3 // indirect
-	github.com/aws/aws-sdk-go-v2/service/sso v1.12.12 // indirect
-	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.14.12 // indirect
-	github.com/aws/aws-sdk-go-v2/service/sts v1.19.2 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.10 // indirect
+	github.com/aws/aws-sdk-go-v2/config v1.29.8 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.34 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.34 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.3 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.34 // indirect
+	github.com/aws/aws-sdk-go-v2/service/iam v1.40.0 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.3 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.4.2 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.15 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.18.2 // indirect
+	github.com/aws/aws-sdk-go-v2/service/sso v1.25.0 // indirect
+	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.29.0 // indirect
 	github.com/bgentry/speakeasy v0.1.0 // indirect
 	github.com/boombuler/barcode v1.0.1-0.20190219062509-6c824513bacc // indirect
 	github.com/cloudflare/circl v1.3.3 // indirect
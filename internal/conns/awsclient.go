@@ -18,26 +18,27 @@ import (
 )
 
 type AWSClient struct {
-	AccountID               string
-	DefaultTagsConfig       *tftags.DefaultConfig
-	DNSSuffix               string
-	IgnoreTagsConfig        *tftags.IgnoreConfig
-	MediaConvertAccountConn *mediaconvert_sdkv1.MediaConvert
-	Partition               string
-	Region                  string
-	ReverseDNSPrefix        string
-	ServicePackages         map[string]ServicePackage
-	Session                 *session_sdkv1.Session
-	TerraformVersion        string
-
-	awsConfig      *aws_sdkv2.Config
-	clients        map[string]any
-	conns          map[string]any
-	endpoints      map[string]string // From provider configuration.
-	httpClient     *http.Client
-	lock           sync.Mutex
-	s3UsePathStyle bool   // From provider configuration.
-	stsRegion      string // From provider configuration.
+	AccountID                string
+	DefaultTagsConfig        *tftags.DefaultConfig
+	DNSSuffix                string
+	MediaConvertAccountConn  *mediaconvert_sdkv1.MediaConvert
+	Partition                string
+	Region                   string
+	ResourceGuardrailsConfig *ResourceGuardrailsConfig
+	ReverseDNSPrefix         string
+	ServicePackages          map[string]ServicePackage
+	Session                  *session_sdkv1.Session
+	TerraformVersion         string
+
+	awsConfig        *aws_sdkv2.Config
+	clients          map[string]any
+	conns            map[string]any
+	endpoints        map[string]string // From provider configuration.
+	httpClient       *http.Client
+	ignoreTagsConfig *tftags.IgnoreConfig // From provider configuration.
+	lock             sync.Mutex
+	s3UsePathStyle   bool   // From provider configuration.
+	stsRegion        string // From provider configuration.
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -74,6 +75,16 @@ func (client *AWSClient) HTTPClient() *http.Client {
 	return client.httpClient
 }
 
+// IgnoreTagsConfig returns the resource tags to ignore.
+// Any ignore_tags scoped to the service package of the resource or data source in context are included.
+func (client *AWSClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
+	if v, ok := FromContext(ctx); ok {
+		return client.ignoreTagsConfig.ForService(v.ServicePackageName)
+	}
+
+	return client.ignoreTagsConfig
+}
+
 // APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the configured AWS Region.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/how-to-call-api.html.
 func (client *AWSClient) APIGatewayInvokeURL(restAPIID, stageName string) string {
@@ -146,6 +157,16 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 		m["sts_region"] = client.stsRegion
 	}
 
+	// Install the service package's known retryable errors into both AWS SDK for Go v1 and v2 clients.
+	if v := names.RetryableErrors(servicePackageName); len(v) > 0 {
+		if client.awsConfig != nil {
+			m["aws_sdkv2_config"] = configWithRetryableErrors(client.awsConfig, v)
+		}
+		if client.Session != nil {
+			m["session"] = sessionWithRetryableErrors(client.Session, v)
+		}
+	}
+
 	return m
 }
 
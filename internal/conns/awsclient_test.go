@@ -1,7 +1,11 @@
 package conns
 
 import (
+	"context"
 	"testing"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -87,3 +91,81 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientIgnoreTagsConfig(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		ignoreTagsConfig: &tftags.IgnoreConfig{
+			Keys: tftags.New(ctx, []interface{}{"ignored"}),
+			Scopes: []*tftags.IgnoreScope{
+				{
+					Services:    []string{names.EC2},
+					KeyPrefixes: tftags.New(ctx, []interface{}{"kubernetes.io/cluster/"}),
+				},
+			},
+		},
+	}
+	tags := tftags.New(ctx, map[string]interface{}{
+		"ignored":                    "value",
+		"kubernetes.io/cluster/test": "owned",
+		"Name":                       "test",
+	})
+
+	testCases := []struct {
+		Name     string
+		Context  context.Context
+		Expected map[string]string
+	}{
+		{
+			Name:    "no resource",
+			Context: ctx,
+			Expected: map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"Name":                       "test",
+			},
+		},
+		{
+			Name:    "in-scope data source",
+			Context: NewDataSourceContext(ctx, names.EC2, "VPC"),
+			Expected: map[string]string{
+				"Name": "test",
+			},
+		},
+		{
+			Name:    "in-scope resource",
+			Context: NewResourceContext(ctx, names.EC2, "VPC"),
+			Expected: map[string]string{
+				"Name": "test",
+			},
+		},
+		{
+			Name:    "out-of-scope resource",
+			Context: NewResourceContext(ctx, names.EKS, "Cluster"),
+			Expected: map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"Name":                       "test",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tags.IgnoreConfig(client.IgnoreTagsConfig(testCase.Context)).Map()
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("got %v, expected %v", got, testCase.Expected)
+			}
+
+			for k, v := range testCase.Expected {
+				if got[k] != v {
+					t.Errorf("got %v, expected %v", got, testCase.Expected)
+				}
+			}
+		})
+	}
+}
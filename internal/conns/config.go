@@ -18,8 +18,9 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	CredentialsCacheDir            string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -33,6 +34,7 @@ type Config struct {
 	MaxRetries                     int
 	Profile                        string
 	Region                         string
+	ResourceGuardrailsConfig       *ResourceGuardrailsConfig
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	SecretKey                      string
@@ -76,10 +78,6 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
-	}
-
 	if c.CustomCABundle != "" {
 		awsbaseConfig.CustomCABundle = c.CustomCABundle
 	}
@@ -107,6 +105,24 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
 	}
 
+	// IAM roles are assumed in order, each using the credentials of the previous role.
+	// aws-sdk-go-base is not used to assume the first role as it retrieves the credentials twice.
+	var cacheKey string
+	for i := range c.AssumeRole {
+		assumeRole := &c.AssumeRole[i]
+		if assumeRole.RoleARN == "" {
+			continue
+		}
+
+		credentialsProvider, key, err := assumeRoleCredentialsProvider(ctx, cfg, assumeRole, c.STSRegion, c.Endpoints[names.STS], c.CredentialsCacheDir, cacheKey)
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+
+		cfg.Credentials = credentialsProvider
+		cacheKey = key
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -159,9 +175,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
-	client.IgnoreTagsConfig = c.IgnoreTagsConfig
+	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.ResourceGuardrailsConfig = c.ResourceGuardrailsConfig
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
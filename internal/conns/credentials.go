@@ -0,0 +1,252 @@
+package conns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// Cached credentials are not reused if they expire within this window.
+	credentialsCacheExpiryWindow = 5 * time.Minute
+)
+
+// assumeRoleCredentialsProvider returns an AWS credentials provider that assumes the specified IAM role
+// using the credentials from the specified AWS SDK for Go v2 configuration.
+// If cacheDir is non-empty, the assumed role credentials are cached in that directory and reused
+// by any provider instance assuming the same role from the same source credentials until they expire.
+// previousCacheKey is the cache key of the previous role in a chain of assumed roles, if any.
+// The returned cache key identifies the role session for the next role in the chain.
+func assumeRoleCredentialsProvider(ctx context.Context, cfg aws_sdkv2.Config, ar *awsbase.AssumeRole, stsRegion, stsEndpoint, cacheDir, previousCacheKey string) (aws_sdkv2.CredentialsProvider, string, error) {
+	tflog.Info(ctx, "Assuming IAM Role", map[string]any{
+		"tf_aws.assume_role.role_arn":        ar.RoleARN,
+		"tf_aws.assume_role.session_name":    ar.SessionName,
+		"tf_aws.assume_role.external_id":     ar.ExternalID,
+		"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+	})
+
+	client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+		if stsRegion != "" {
+			o.Region = stsRegion
+		}
+		if stsEndpoint != "" {
+			o.EndpointResolver = sts_sdkv2.EndpointResolverFromURL(stsEndpoint)
+		}
+	})
+
+	var provider aws_sdkv2.CredentialsProvider = stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = ar.SessionName
+		o.Duration = ar.Duration
+
+		if ar.ExternalID != "" {
+			o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+		}
+
+		if ar.Policy != "" {
+			o.Policy = aws_sdkv2.String(ar.Policy)
+		}
+
+		for _, v := range ar.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+				Arn: aws_sdkv2.String(v),
+			})
+		}
+
+		for k, v := range ar.Tags {
+			o.Tags = append(o.Tags, ststypes.Tag{
+				Key:   aws_sdkv2.String(k),
+				Value: aws_sdkv2.String(v),
+			})
+		}
+
+		if len(ar.TransitiveTagKeys) > 0 {
+			o.TransitiveTagKeys = ar.TransitiveTagKeys
+		}
+
+		if ar.SourceIdentity != "" {
+			o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+		}
+	})
+
+	var cacheKey string
+
+	if cacheDir != "" {
+		// Cached credentials must only be returned to the principal that STS authorized to assume the role,
+		// so the key includes the source credentials as well as where and how the role is assumed.
+		source, err := cfg.Credentials.Retrieve(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving source credentials for IAM Role (%s): %w", ar.RoleARN, err)
+		}
+
+		region := cfg.Region
+		if stsRegion != "" {
+			region = stsRegion
+		}
+
+		cacheKey = assumeRoleCacheKey(ar, source, previousCacheKey, region, stsEndpoint)
+
+		provider = &fileCredentialsCache{
+			path:     filepath.Join(cacheDir, cacheKey+".json"),
+			provider: provider,
+		}
+	}
+
+	credentialsCache := aws_sdkv2.NewCredentialsCache(provider)
+
+	if _, err := credentialsCache.Retrieve(ctx); err != nil {
+		return nil, "", fmt.Errorf("assuming IAM Role (%s): %w", ar.RoleARN, err)
+	}
+
+	return credentialsCache, cacheKey, nil
+}
+
+// assumeRoleCacheKey returns a stable key identifying the IAM role session described by the specified configuration,
+// assumed using the specified source credentials from the specified STS Region and endpoint.
+// previousCacheKey is the cache key of the previous role in a chain of assumed roles, if any.
+func assumeRoleCacheKey(ar *awsbase.AssumeRole, source aws_sdkv2.Credentials, previousCacheKey, stsRegion, stsEndpoint string) string {
+	tags := make([]string, 0, len(ar.Tags))
+	for k, v := range ar.Tags {
+		tags = append(tags, k+"="+v)
+	}
+	sort.Strings(tags)
+
+	policyARNs := append([]string{}, ar.PolicyARNs...)
+	sort.Strings(policyARNs)
+
+	transitiveTagKeys := append([]string{}, ar.TransitiveTagKeys...)
+	sort.Strings(transitiveTagKeys)
+
+	h := sha256.New()
+	for _, v := range []string{
+		source.AccessKeyID,
+		source.SecretAccessKey,
+		source.SessionToken,
+		previousCacheKey,
+		stsRegion,
+		stsEndpoint,
+		ar.RoleARN,
+		ar.SessionName,
+		ar.ExternalID,
+		ar.SourceIdentity,
+		ar.Duration.String(),
+		ar.Policy,
+		strings.Join(policyARNs, ","),
+		strings.Join(tags, ","),
+		strings.Join(transitiveTagKeys, ","),
+	} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// fileCredentialsCache is an AWS credentials provider that caches the credentials retrieved by another provider in a file.
+type fileCredentialsCache struct {
+	path     string
+	provider aws_sdkv2.CredentialsProvider
+}
+
+type cachedCredentials struct {
+	AccessKeyID     string    `json:"AccessKeyId"`
+	SecretAccessKey string    `json:"SecretAccessKey"`
+	SessionToken    string    `json:"SessionToken"`
+	Expires         time.Time `json:"Expiration"`
+}
+
+func (c *fileCredentialsCache) Retrieve(ctx context.Context) (aws_sdkv2.Credentials, error) {
+	if v, err := c.read(); err == nil && v.Expires.After(time.Now().Add(credentialsCacheExpiryWindow)) {
+		tflog.Debug(ctx, "Using cached credentials", map[string]any{
+			"tf_aws.credentials_cache.path": c.path,
+		})
+
+		return aws_sdkv2.Credentials{
+			AccessKeyID:     v.AccessKeyID,
+			SecretAccessKey: v.SecretAccessKey,
+			SessionToken:    v.SessionToken,
+			Source:          stscreds.ProviderName,
+			CanExpire:       true,
+			Expires:         v.Expires,
+		}, nil
+	}
+
+	credentials, err := c.provider.Retrieve(ctx)
+	if err != nil {
+		return aws_sdkv2.Credentials{}, err
+	}
+
+	if credentials.CanExpire {
+		if err := c.write(credentials); err != nil {
+			// Caching is an optimization only.
+			tflog.Warn(ctx, "Unable to cache credentials", map[string]any{
+				"tf_aws.credentials_cache.path": c.path,
+				"error":                         err.Error(),
+			})
+		}
+	}
+
+	return credentials, nil
+}
+
+func (c *fileCredentialsCache) read() (*cachedCredentials, error) {
+	b, err := os.ReadFile(c.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var v cachedCredentials
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+func (c *fileCredentialsCache) write(credentials aws_sdkv2.Credentials) error {
+	b, err := json.Marshal(cachedCredentials{
+		AccessKeyID:     credentials.AccessKeyID,
+		SecretAccessKey: credentials.SecretAccessKey,
+		SessionToken:    credentials.SessionToken,
+		Expires:         credentials.Expires,
+	})
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(c.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename so that concurrent provider instances never read a partial file.
+	f, err := os.CreateTemp(dir, filepath.Base(c.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), c.path)
+}
@@ -0,0 +1,144 @@
+package conns
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+type mockCredentialsProvider struct {
+	calls   int
+	expires time.Time
+}
+
+func (p *mockCredentialsProvider) Retrieve(context.Context) (aws_sdkv2.Credentials, error) {
+	p.calls++
+
+	return aws_sdkv2.Credentials{
+		AccessKeyID:     "ASIAEXAMPLE",
+		SecretAccessKey: "secret",
+		SessionToken:    "token",
+		CanExpire:       true,
+		Expires:         p.expires,
+	}, nil
+}
+
+func TestFileCredentialsCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name          string
+		Expires       time.Time
+		ExpectedCalls int
+	}{
+		{
+			Name:          "valid",
+			Expires:       time.Now().Add(1 * time.Hour),
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "expiring",
+			Expires:       time.Now().Add(1 * time.Minute),
+			ExpectedCalls: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "cache", "key.json")
+			provider := &mockCredentialsProvider{expires: testCase.Expires}
+
+			// Simulate two provider instances sharing the same cache.
+			for i := 0; i < 2; i++ {
+				cache := &fileCredentialsCache{
+					path:     path,
+					provider: provider,
+				}
+
+				got, err := cache.Retrieve(ctx)
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got.AccessKeyID != "ASIAEXAMPLE" || got.SessionToken != "token" {
+					t.Errorf("unexpected credentials: %#v", got)
+				}
+			}
+
+			if got, want := provider.calls, testCase.ExpectedCalls; got != want {
+				t.Errorf("credentials provider calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestAssumeRoleCacheKey(t *testing.T) {
+	t.Parallel()
+
+	type cacheKeyInput struct {
+		assumeRole       awsbase.AssumeRole
+		source           aws_sdkv2.Credentials
+		previousCacheKey string
+		stsRegion        string
+		stsEndpoint      string
+	}
+
+	key := func(v cacheKeyInput) string {
+		return assumeRoleCacheKey(&v.assumeRole, v.source, v.previousCacheKey, v.stsRegion, v.stsEndpoint)
+	}
+
+	base := cacheKeyInput{
+		assumeRole: awsbase.AssumeRole{
+			RoleARN:     "arn:aws:iam::123456789012:role/test",
+			SessionName: "session",
+			ExternalID:  "external",
+			Tags: map[string]string{
+				"k1": "v1",
+				"k2": "v2",
+			},
+			PolicyARNs: []string{"arn:aws:iam::aws:policy/a", "arn:aws:iam::aws:policy/b"},
+		},
+		source: aws_sdkv2.Credentials{
+			AccessKeyID:     "AKIAEXAMPLE",
+			SecretAccessKey: "secret",
+		},
+		stsRegion: "us-west-2", //lintignore:AWSAT003
+	}
+
+	reordered := base
+	reordered.assumeRole.PolicyARNs = []string{"arn:aws:iam::aws:policy/b", "arn:aws:iam::aws:policy/a"}
+
+	if got, want := key(reordered), key(base); got != want {
+		t.Errorf("cache key for reordered policy ARNs = %s, want %s", got, want)
+	}
+
+	for name, f := range map[string]func(*cacheKeyInput){
+		"role ARN":           func(v *cacheKeyInput) { v.assumeRole.RoleARN = "arn:aws:iam::123456789012:role/other" },
+		"session name":       func(v *cacheKeyInput) { v.assumeRole.SessionName = "other" },
+		"external ID":        func(v *cacheKeyInput) { v.assumeRole.ExternalID = "other" },
+		"tags":               func(v *cacheKeyInput) { v.assumeRole.Tags = map[string]string{"k1": "v1"} },
+		"source access key":  func(v *cacheKeyInput) { v.source.AccessKeyID = "AKIAOTHER" },
+		"source secret key":  func(v *cacheKeyInput) { v.source.SecretAccessKey = "other" },
+		"source token":       func(v *cacheKeyInput) { v.source.SessionToken = "token" },
+		"previous cache key": func(v *cacheKeyInput) { v.previousCacheKey = "previous" },
+		"STS Region":         func(v *cacheKeyInput) { v.stsRegion = "us-east-1" }, //lintignore:AWSAT003
+		"STS endpoint":       func(v *cacheKeyInput) { v.stsEndpoint = "https://sts.example.com" },
+	} {
+		v := base
+		f(&v)
+
+		if key(v) == key(base) {
+			t.Errorf("cache key unchanged after changing %s", name)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package conns
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+// ConfirmDeleteEnvVar is the environment variable used to confirm the deletion of
+// resources whose types are listed in the provider's `resource_guardrails.confirm_delete`.
+// Its value is a comma-separated list of resource type patterns, e.g. "aws_s3_bucket,aws_rds_*".
+const ConfirmDeleteEnvVar = "TF_AWS_CONFIRM_DELETE"
+
+// ResourceGuardrailsConfig contains provider-wide guardrails for operations on resources.
+// Resource types are matched using shell file name patterns, e.g. "aws_rds_*".
+type ResourceGuardrailsConfig struct {
+	ConfirmDelete []string // Resource types whose deletion must be confirmed via the TF_AWS_CONFIRM_DELETE environment variable
+	DenyDelete    []string // Resource types whose deletion is always denied
+}
+
+// CheckDelete returns an error if the provider configuration does not allow deleting a resource of the specified type.
+func (c *ResourceGuardrailsConfig) CheckDelete(typeName string) error {
+	if c == nil {
+		return nil
+	}
+
+	if matchResourceType(c.DenyDelete, typeName) {
+		return fmt.Errorf("deletion of %s resources is denied by the provider's resource_guardrails configuration", typeName)
+	}
+
+	if matchResourceType(c.ConfirmDelete, typeName) {
+		if !matchResourceType(strings.Split(os.Getenv(ConfirmDeleteEnvVar), ","), typeName) {
+			return fmt.Errorf("deletion of %s resources must be confirmed by setting the %s environment variable to include %q", typeName, ConfirmDeleteEnvVar, typeName)
+		}
+	}
+
+	return nil
+}
+
+// matchResourceType returns whether the resource type matches any of the specified patterns.
+func matchResourceType(patterns []string, typeName string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		if ok, _ := path.Match(pattern, typeName); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,107 @@
+package conns
+
+import (
+	"testing"
+)
+
+func TestResourceGuardrailsConfigCheckDelete(t *testing.T) { //nolint:paralleltest
+	testCases := []struct {
+		Name          string
+		Config        *ResourceGuardrailsConfig
+		TypeName      string
+		ConfirmEnvVar string
+		ExpectError   bool
+	}{
+		{
+			Name:     "nil config",
+			TypeName: "aws_s3_bucket",
+		},
+		{
+			Name:     "empty config",
+			Config:   &ResourceGuardrailsConfig{},
+			TypeName: "aws_s3_bucket",
+		},
+		{
+			Name: "deny exact",
+			Config: &ResourceGuardrailsConfig{
+				DenyDelete: []string{"aws_s3_bucket"},
+			},
+			TypeName:    "aws_s3_bucket",
+			ExpectError: true,
+		},
+		{
+			Name: "deny other type",
+			Config: &ResourceGuardrailsConfig{
+				DenyDelete: []string{"aws_s3_bucket"},
+			},
+			TypeName: "aws_s3_bucket_policy",
+		},
+		{
+			Name: "deny wildcard",
+			Config: &ResourceGuardrailsConfig{
+				DenyDelete: []string{"aws_rds_*"},
+			},
+			TypeName:    "aws_rds_cluster",
+			ExpectError: true,
+		},
+		{
+			Name: "deny ignores confirmation",
+			Config: &ResourceGuardrailsConfig{
+				DenyDelete: []string{"aws_kms_key"},
+			},
+			TypeName:      "aws_kms_key",
+			ConfirmEnvVar: "aws_kms_key",
+			ExpectError:   true,
+		},
+		{
+			Name: "confirm not confirmed",
+			Config: &ResourceGuardrailsConfig{
+				ConfirmDelete: []string{"aws_dynamodb_table"},
+			},
+			TypeName:    "aws_dynamodb_table",
+			ExpectError: true,
+		},
+		{
+			Name: "confirm other type confirmed",
+			Config: &ResourceGuardrailsConfig{
+				ConfirmDelete: []string{"aws_dynamodb_table"},
+			},
+			TypeName:      "aws_dynamodb_table",
+			ConfirmEnvVar: "aws_s3_bucket",
+			ExpectError:   true,
+		},
+		{
+			Name: "confirm confirmed",
+			Config: &ResourceGuardrailsConfig{
+				ConfirmDelete: []string{"aws_dynamodb_table"},
+			},
+			TypeName:      "aws_dynamodb_table",
+			ConfirmEnvVar: "aws_s3_bucket, aws_dynamodb_table",
+		},
+		{
+			Name: "confirm confirmed wildcard",
+			Config: &ResourceGuardrailsConfig{
+				ConfirmDelete: []string{"aws_rds_*"},
+			},
+			TypeName:      "aws_rds_cluster",
+			ConfirmEnvVar: "aws_rds_*",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv(ConfirmDeleteEnvVar, testCase.ConfirmEnvVar)
+
+			err := testCase.Config.CheckDelete(testCase.TypeName)
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("got no error, expected error")
+			}
+		})
+	}
+}
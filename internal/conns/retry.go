@@ -0,0 +1,99 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	smithy "github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// isRetryableErrorV1 returns whether the AWS SDK for Go v1 error matches any of the specified retryable errors.
+func isRetryableErrorV1(err error, retryableErrors []names.RetryableError) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+
+	return isRetryableError(awsErr.Code(), awsErr.Message(), retryableErrors)
+}
+
+// isRetryableErrorV2 returns whether the AWS SDK for Go v2 error matches any of the specified retryable errors.
+func isRetryableErrorV2(err error, retryableErrors []names.RetryableError) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return isRetryableError(apiErr.ErrorCode(), apiErr.ErrorMessage(), retryableErrors)
+}
+
+func isRetryableError(code, message string, retryableErrors []names.RetryableError) bool {
+	for _, v := range retryableErrors {
+		if code == v.ErrorCode && strings.Contains(message, v.ErrorMessage) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sessionWithRetryableErrors returns a copy of the AWS SDK for Go v1 session with a request handler
+// that marks the specified errors as retryable.
+func sessionWithRetryableErrors(sess *session_sdkv1.Session, retryableErrors []names.RetryableError) *session_sdkv1.Session {
+	sess = sess.Copy()
+	sess.Handlers.Retry.PushBack(func(r *request_sdkv1.Request) {
+		if isRetryableErrorV1(r.Error, retryableErrors) {
+			r.Retryable = aws_sdkv1.Bool(true)
+		}
+	})
+
+	return sess
+}
+
+// configWithRetryableErrors returns a copy of the AWS SDK for Go v2 configuration whose retryer
+// treats the specified errors as retryable.
+func configWithRetryableErrors(cfg *aws_sdkv2.Config, retryableErrors []names.RetryableError) *aws_sdkv2.Config {
+	v := cfg.Copy()
+
+	if newRetryer := v.Retryer; newRetryer != nil {
+		v.Retryer = func() aws_sdkv2.Retryer {
+			return &retryableErrorsRetryer{
+				Retryer:         newRetryer(),
+				retryableErrors: retryableErrors,
+			}
+		}
+	}
+
+	return &v
+}
+
+// retryableErrorsRetryer is an AWS SDK for Go v2 retryer that additionally retries a set of known errors.
+type retryableErrorsRetryer struct {
+	aws_sdkv2.Retryer
+	retryableErrors []names.RetryableError
+}
+
+var _ aws_sdkv2.RetryerV2 = (*retryableErrorsRetryer)(nil)
+
+func (r *retryableErrorsRetryer) IsErrorRetryable(err error) bool {
+	if isRetryableErrorV2(err, r.retryableErrors) {
+		return true
+	}
+
+	return r.Retryer.IsErrorRetryable(err)
+}
+
+func (r *retryableErrorsRetryer) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	if v, ok := r.Retryer.(aws_sdkv2.RetryerV2); ok {
+		return v.GetAttemptToken(ctx)
+	}
+
+	return r.Retryer.GetInitialToken(), nil
+}
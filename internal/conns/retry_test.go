@@ -0,0 +1,210 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	credentials_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials"
+	lambda_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	t.Parallel()
+
+	retryableErrors := []names.RetryableError{
+		{
+			ErrorCode:    "InvalidParameterValueException",
+			ErrorMessage: "cannot be assumed",
+		},
+		{
+			ErrorCode: "DependencyViolation",
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil error",
+		},
+		{
+			Name: "other error",
+			Err:  errors.New("cannot be assumed"),
+		},
+		{
+			Name:     "v1 code and message",
+			Err:      awserr.New("InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda.", nil),
+			Expected: true,
+		},
+		{
+			Name:     "v1 wrapped code and message",
+			Err:      fmt.Errorf("creating Lambda Function: %w", awserr.New("InvalidParameterValueException", "The role cannot be assumed", nil)),
+			Expected: true,
+		},
+		{
+			Name:     "v1 code only",
+			Err:      awserr.New("DependencyViolation", "resource has a dependent object", nil),
+			Expected: true,
+		},
+		{
+			Name: "v1 code other message",
+			Err:  awserr.New("InvalidParameterValueException", "Runtime is not supported", nil),
+		},
+		{
+			Name:     "v2 code and message",
+			Err:      &smithy.GenericAPIError{Code: "InvalidParameterValueException", Message: "The role defined for the function cannot be assumed by Lambda."},
+			Expected: true,
+		},
+		{
+			Name:     "v2 wrapped code only",
+			Err:      fmt.Errorf("deleting Security Group: %w", &smithy.GenericAPIError{Code: "DependencyViolation", Message: "resource has a dependent object"}),
+			Expected: true,
+		},
+		{
+			Name: "v2 other code",
+			Err:  &smithy.GenericAPIError{Code: "ValidationException", Message: "cannot be assumed"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := isRetryableErrorV1(testCase.Err, retryableErrors) || isRetryableErrorV2(testCase.Err, retryableErrors)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+type retryTestServicePackage struct{}
+
+func (p *retryTestServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (p *retryTestServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (p *retryTestServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (p *retryTestServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (p *retryTestServicePackage) ServicePackageName() string {
+	return names.Lambda
+}
+
+func (p *retryTestServicePackage) NewClient(_ context.Context, config map[string]any) (*lambda_sdkv2.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+
+	return lambda_sdkv2.NewFromConfig(cfg, func(o *lambda_sdkv2.Options) {
+		o.EndpointResolver = lambda_sdkv2.EndpointResolverFromURL(config["endpoint"].(string))
+	}), nil
+}
+
+func TestAWSClientRetryableErrorsV2(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name             string
+		ErrorMessage     string
+		ExpectedRequests int32
+		ExpectError      bool
+	}{
+		{
+			Name:             "retryable error",
+			ErrorMessage:     "The role defined for the function cannot be assumed by Lambda.",
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "other error",
+			ErrorMessage:     "Runtime is not supported",
+			ExpectedRequests: 1,
+			ExpectError:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			// The first two requests fail with the configured error, subsequent requests succeed.
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) <= 2 {
+					w.Header().Set("X-Amzn-Errortype", "InvalidParameterValueException")
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprintf(w, `{"message":%q}`, testCase.ErrorMessage)
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{}`)
+			}))
+			defer server.Close()
+
+			c := &AWSClient{
+				ServicePackages: map[string]ServicePackage{
+					names.Lambda: &retryTestServicePackage{},
+				},
+				awsConfig: &aws_sdkv2.Config{
+					Credentials: credentials_sdkv2.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+					Region:      "us-west-2", //lintignore:AWSAT003
+					Retryer: func() aws_sdkv2.Retryer {
+						return retry_sdkv2.NewStandard(func(o *retry_sdkv2.StandardOptions) {
+							o.MaxAttempts = 5
+							o.Backoff = retry_sdkv2.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+								return 0, nil
+							})
+						})
+					},
+				},
+				clients: map[string]any{},
+				endpoints: map[string]string{
+					names.Lambda: server.URL,
+				},
+			}
+
+			conn, err := client[*lambda_sdkv2.Client](ctx, c, names.Lambda)
+
+			if err != nil {
+				t.Fatalf("creating client: %s", err)
+			}
+
+			_, err = conn.GetAccountSettings(ctx, &lambda_sdkv2.GetAccountSettingsInput{})
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("got error %v, expected error: %t", err, want)
+			}
+
+			if got, want := atomic.LoadInt32(&requests), testCase.ExpectedRequests; got != want {
+				t.Errorf("got %d requests, expected %d", got, want)
+			}
+		})
+	}
+}
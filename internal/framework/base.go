@@ -60,7 +60,7 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	}
 
 	defaultTagsConfig := r.Meta().DefaultTagsConfig
-	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
+	ignoreTagsConfig := r.Meta().IgnoreTagsConfig(ctx)
 
 	var planTags types.Map
 
@@ -27,6 +27,26 @@ func Int64ToFramework(_ context.Context, v *int64) types.Int64 {
 	return types.Int64Value(aws.ToInt64(v))
 }
 
+// Int32FromFramework converts a Framework Int64 value to an int32 pointer.
+// A null Int64 is converted to a nil int32 pointer.
+func Int32FromFramework(_ context.Context, v types.Int64) *int32 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return aws.Int32(int32(v.ValueInt64()))
+}
+
+// Int32ToFramework converts an int32 pointer to a Framework Int64 value.
+// A nil int32 pointer is converted to a null Int64.
+func Int32ToFramework(_ context.Context, v *int32) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(aws.ToInt32(v)))
+}
+
 // Int64ToFrameworkLegacy converts an int64 pointer to a Framework Int64 value.
 // A nil int64 pointer is converted to a zero Int64.
 func Int64ToFrameworkLegacy(_ context.Context, v *int64) types.Int64 {
@@ -75,6 +75,7 @@ var (
 	sdkVersion        = flag.Int("AWSSDKVersion", sdkV1, "Version of the AWS SDK Go to use i.e. 1 or 2")
 	kvtValues         = flag.Bool("KVTValues", false, "Whether KVT string map is of string pointers")
 	skipServiceImp    = flag.Bool("SkipAWSServiceImp", false, "Whether to skip importing the AWS service package")
+	skipAWSImp        = flag.Bool("SkipAWSImp", false, "Whether to skip importing the AWS Go SDK aws package")
 	skipNamesImp      = flag.Bool("SkipNamesImp", false, "Whether to skip importing names")
 	skipTypesImp      = flag.Bool("SkipTypesImp", false, "Whether to skip importing types")
 )
@@ -183,6 +184,7 @@ type TemplateData struct {
 	HelperSchemaPkg  bool
 	InternalTypesPkg bool
 	NamesPkg         bool
+	SkipAWSImp       bool
 	SkipServiceImp   bool
 	SkipTypesImp     bool
 	TfResourcePkg    bool
@@ -271,6 +273,7 @@ func main() {
 		HelperSchemaPkg:  awsPkg == "autoscaling",
 		InternalTypesPkg: (*listTags && *listTagsFunc == defaultListTagsFunc) || *serviceTagsMap || *serviceTagsSlice,
 		NamesPkg:         *updateTags && !*skipNamesImp,
+		SkipAWSImp:       *skipAWSImp,
 		SkipServiceImp:   *skipServiceImp,
 		SkipTypesImp:     *skipTypesImp,
 		TfResourcePkg:    *getTag,
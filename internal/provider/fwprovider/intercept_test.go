@@ -0,0 +1,97 @@
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type testResource struct {
+	deleted bool
+}
+
+func (r *testResource) Metadata(context.Context, resource.MetadataRequest, *resource.MetadataResponse) {
+}
+
+func (r *testResource) Schema(context.Context, resource.SchemaRequest, *resource.SchemaResponse) {
+}
+
+func (r *testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+}
+
+func (r *testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+	r.deleted = true
+}
+
+func (r *testResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+
+func TestGuardrailsInterceptorDelete(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Guardrails    *conns.ResourceGuardrailsConfig
+		ExpectDeleted bool
+	}{
+		{
+			Name:          "no guardrails",
+			ExpectDeleted: true,
+		},
+		{
+			Name: "other resource type denied",
+			Guardrails: &conns.ResourceGuardrailsConfig{
+				DenyDelete: []string{"aws_s3_bucket"},
+			},
+			ExpectDeleted: true,
+		},
+		{
+			Name: "denied",
+			Guardrails: &conns.ResourceGuardrailsConfig{
+				DenyDelete: []string{"aws_rds_*"},
+			},
+		},
+		{
+			Name: "confirmation required",
+			Guardrails: &conns.ResourceGuardrailsConfig{
+				ConfirmDelete: []string{"aws_rds_cluster"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			inner := &testResource{}
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				return ctx
+			}
+			w := newWrappedResource(bootstrapContext, inner, resourceInterceptors{
+				guardrailsInterceptor{typeName: "aws_rds_cluster"},
+			}).(*wrappedResource)
+			w.meta = &conns.AWSClient{
+				ResourceGuardrailsConfig: testCase.Guardrails,
+			}
+
+			var response resource.DeleteResponse
+			w.Delete(context.Background(), resource.DeleteRequest{}, &response)
+
+			if got, want := inner.deleted, testCase.ExpectDeleted; got != want {
+				t.Errorf("deleted = %t, want %t", got, want)
+			}
+			if got, want := response.Diagnostics.HasError(), !testCase.ExpectDeleted; got != want {
+				t.Errorf("Diagnostics.HasError() = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -48,6 +48,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"credentials_cache_dir": schema.StringAttribute{
+				Optional:    true,
+				Description: "Directory in which credentials obtained by assuming an IAM Role are cached. Cached credentials are reused by all provider instances assuming the same role until they expire.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -141,9 +145,6 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -254,12 +255,66 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tag keys to ignore across all resources.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"scope": schema.ListNestedBlock{
+							Description: "Configuration block with settings to ignore resource tags of specific services only.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_prefixes": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tag key prefixes to ignore across all resources of the services.",
+									},
+									"key_regexes": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Regular expressions matching resource tag keys to ignore across all resources of the services.",
+									},
+									"keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tag keys to ignore across all resources of the services.",
+									},
+									"services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Services, identified by their provider service package names (e.g. `ec2`), to which the settings apply.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resource_guardrails": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to guard operations on resources of specific types.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"confirm_delete": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types whose deletion must be confirmed using the `TF_AWS_CONFIRM_DELETE` environment variable. Resource types can contain wildcards, e.g. `aws_rds_*`.",
+						},
+						"deny_delete": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types whose deletion is denied. Resource types can contain wildcards, e.g. `aws_rds_*`.",
+						},
+					},
 				},
 			},
 		},
@@ -304,7 +359,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig(ctx))
 				}
 
 				return ctx
@@ -348,12 +403,14 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig(ctx))
 				}
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{
+				guardrailsInterceptor{typeName: typeName},
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
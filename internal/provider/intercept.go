@@ -190,6 +190,30 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
+// guardrailsInterceptor enforces the provider-wide resource guardrails.
+type guardrailsInterceptor struct {
+	typeName string
+}
+
+func (r guardrailsInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	v, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Delete:
+			if err := v.ResourceGuardrailsConfig.CheckDelete(r.typeName); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "deleting %s (%s): %s", r.typeName, d.Id(), err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
 type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // tagsInterceptor implements transparent tagging.
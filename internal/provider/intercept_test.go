@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -90,3 +91,78 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestGuardrailsInterceptorDelete(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Guardrails    *conns.ResourceGuardrailsConfig
+		ExpectDeleted bool
+	}{
+		{
+			Name:          "no guardrails",
+			ExpectDeleted: true,
+		},
+		{
+			Name: "other resource type denied",
+			Guardrails: &conns.ResourceGuardrailsConfig{
+				DenyDelete: []string{"aws_s3_bucket"},
+			},
+			ExpectDeleted: true,
+		},
+		{
+			Name: "denied",
+			Guardrails: &conns.ResourceGuardrailsConfig{
+				DenyDelete: []string{"aws_rds_*"},
+			},
+		},
+		{
+			Name: "confirmation required",
+			Guardrails: &conns.ResourceGuardrailsConfig{
+				ConfirmDelete: []string{"aws_rds_cluster"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &wrappedResource{
+				bootstrapContext: func(ctx context.Context, meta any) context.Context {
+					return ctx
+				},
+				interceptors: interceptorItems{
+					{
+						when:        Before,
+						why:         Delete,
+						interceptor: guardrailsInterceptor{typeName: "aws_rds_cluster"},
+					},
+				},
+			}
+
+			var deleted bool
+			var delete schema.DeleteContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				deleted = true
+				return nil
+			}
+
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+			d.SetId("test")
+			meta := &conns.AWSClient{
+				ResourceGuardrailsConfig: testCase.Guardrails,
+			}
+
+			diags := r.Delete(delete)(context.Background(), d, meta)
+
+			if got, want := deleted, testCase.ExpectDeleted; got != want {
+				t.Errorf("deleted = %t, want %t", got, want)
+			}
+			if got, want := diags.HasError(), !testCase.ExpectDeleted; got != want {
+				t.Errorf("diags.HasError() = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	homedir "github.com/mitchellh/go-homedir"
 )
 
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
@@ -46,6 +47,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"credentials_cache_dir": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Directory in which credentials obtained by assuming an IAM Role are cached. " +
+					"Cached credentials are reused by all provider instances assuming the same role until they expire.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -116,6 +123,59 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"scope": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block with settings to ignore resource tags of specific services only.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Resource tag keys to ignore across all resources of the services.",
+									},
+									"key_prefixes": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Resource tag key prefixes to ignore across all resources of the services.",
+									},
+									"key_regexes": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsValidRegExp,
+										},
+										Set:         schema.HashString,
+										Description: "Regular expressions matching resource tag keys to ignore across all resources of the services.",
+									},
+									"services": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+										Set:         schema.HashString,
+										Description: "Services, identified by their provider service package names (e.g. `ec2`), to which the settings apply.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -144,6 +204,31 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"resource_guardrails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to guard operations on resources of specific types.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"confirm_delete": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Description: "Resource types whose deletion must be confirmed using the `TF_AWS_CONFIRM_DELETE` environment variable. " +
+								"Resource types can contain wildcards, e.g. `aws_rds_*`.",
+						},
+						"deny_delete": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource types whose deletion is denied. Resource types can contain wildcards, e.g. `aws_rds_*`.",
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -264,7 +349,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig(ctx))
 				}
 
 				return ctx
@@ -315,12 +400,20 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig(ctx))
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when: Before,
+					why:  Delete,
+					interceptor: guardrailsInterceptor{
+						typeName: typeName,
+					},
+				},
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -453,14 +546,22 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok {
+		for i, v := range v.([]interface{}) {
+			if v == nil {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, v.(map[string]interface{}))
+			config.AssumeRole = append(config.AssumeRole, *assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -471,6 +572,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("credentials_cache_dir"); ok && v.(string) != "" {
+		dir, err := homedir.Expand(v.(string))
+		if err != nil {
+			return nil, diag.Errorf("expanding credentials_cache_dir (%s): %s", v, err)
+		}
+		config.CredentialsCacheDir = dir
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -490,13 +599,22 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		ignoreTagsConfig, err := expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.IgnoreTagsConfig = ignoreTagsConfig
 	}
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("resource_guardrails"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.ResourceGuardrailsConfig = expandResourceGuardrails(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -532,7 +650,6 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -777,9 +894,9 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
-func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
+func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.IgnoreConfig, error) {
 	if tfMap == nil {
-		return nil
+		return nil, nil
 	}
 
 	ignoreConfig := &tftags.IgnoreConfig{}
@@ -792,7 +909,83 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, v.List())
 	}
 
-	return ignoreConfig
+	if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+		regexes, err := expandIgnoreTagsKeyRegexes(v)
+		if err != nil {
+			return nil, err
+		}
+
+		ignoreConfig.KeyRegexes = regexes
+	}
+
+	if v, ok := tfMap["scope"].([]interface{}); ok {
+		for _, v := range v {
+			tfMap, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			scope := &tftags.IgnoreScope{}
+
+			if v, ok := tfMap["services"].(*schema.Set); ok {
+				scope.Services = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["keys"].(*schema.Set); ok {
+				scope.Keys = tftags.New(ctx, v.List())
+			}
+
+			if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
+				scope.KeyPrefixes = tftags.New(ctx, v.List())
+			}
+
+			if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+				regexes, err := expandIgnoreTagsKeyRegexes(v)
+				if err != nil {
+					return nil, err
+				}
+
+				scope.KeyRegexes = regexes
+			}
+
+			ignoreConfig.Scopes = append(ignoreConfig.Scopes, scope)
+		}
+	}
+
+	return ignoreConfig, nil
+}
+
+func expandIgnoreTagsKeyRegexes(tfSet *schema.Set) ([]*regexp.Regexp, error) {
+	var regexes []*regexp.Regexp
+
+	for _, v := range flex.ExpandStringValueSet(tfSet) {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("compiling ignore_tags key regular expression (%s): %w", v, err)
+		}
+
+		regexes = append(regexes, re)
+	}
+
+	return regexes, nil
+}
+
+func expandResourceGuardrails(_ context.Context, tfMap map[string]interface{}) *conns.ResourceGuardrailsConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	guardrailsConfig := &conns.ResourceGuardrailsConfig{}
+
+	if v, ok := tfMap["confirm_delete"].(*schema.Set); ok && v.Len() > 0 {
+		guardrailsConfig.ConfirmDelete = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["deny_delete"].(*schema.Set); ok && v.Len() > 0 {
+		guardrailsConfig.DenyDelete = flex.ExpandStringValueSet(v)
+	}
+
+	return guardrailsConfig
 }
 
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
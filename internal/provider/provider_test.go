@@ -2,10 +2,17 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -50,6 +57,24 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandIgnoreTagsInvalidKeyRegex(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tfMap := map[string]interface{}{
+		"scope": []interface{}{
+			map[string]interface{}{
+				"key_regexes": schema.NewSet(schema.HashString, []interface{}{"^kubernetes.io/(cluster/"}),
+				"services":    schema.NewSet(schema.HashString, []interface{}{"ec2"}),
+			},
+		},
+	}
+
+	if _, err := expandIgnoreTags(ctx, tfMap); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
@@ -202,3 +227,173 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+// assumeRoleCall records the IAM role assumed by an sts:AssumeRole call and the access key that signed the call.
+type assumeRoleCall struct {
+	RoleARN     string
+	AccessKeyID string
+}
+
+// newAssumeRoleServer returns a mock STS endpoint that answers sts:AssumeRole with credentials whose
+// access key is derived from the role name, and records every call.
+func newAssumeRoleServer(t *testing.T) (*httptest.Server, func() []assumeRoleCall) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var calls []assumeRoleCall
+	credential := regexp.MustCompile(`Credential=([^/]+)/`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.Form.Get("Action") != "AssumeRole" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		roleARN := r.Form.Get("RoleArn")
+		var accessKeyID string
+		if m := credential.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+			accessKeyID = m[1]
+		}
+
+		mu.Lock()
+		calls = append(calls, assumeRoleCall{RoleARN: roleARN, AccessKeyID: accessKeyID})
+		mu.Unlock()
+
+		roleName := roleARN[strings.LastIndex(roleARN, "/")+1:]
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>%[1]s/session</Arn>
+      <AssumedRoleId>AROAEXAMPLE:session</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>ASIA%[2]s</AccessKeyId>
+      <SecretAccessKey>secret-%[2]s</SecretAccessKey>
+      <SessionToken>token-%[2]s</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`, roleARN, strings.ToUpper(roleName))
+	}))
+
+	return server, func() []assumeRoleCall {
+		mu.Lock()
+		defer mu.Unlock()
+
+		v := calls
+		calls = nil
+		return v
+	}
+}
+
+func TestConfigureAssumeRoleChain(t *testing.T) { //nolint:paralleltest
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	ctx := context.Background()
+	server, calls := newAssumeRoleServer(t)
+	defer server.Close()
+
+	p, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(accessKey, cacheDir string) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+			"access_key": accessKey,
+			"assume_role": []interface{}{
+				map[string]interface{}{
+					"role_arn":     "arn:aws:iam::111111111111:role/first",
+					"session_name": "first",
+				},
+				map[string]interface{}{
+					"role_arn":     "arn:aws:iam::222222222222:role/second",
+					"session_name": "second",
+				},
+				map[string]interface{}{
+					"role_arn":     "arn:aws:iam::333333333333:role/third",
+					"session_name": "third",
+				},
+			},
+			"credentials_cache_dir": cacheDir,
+			"endpoints": []interface{}{
+				map[string]interface{}{
+					"sts": server.URL,
+				},
+			},
+			"region":                      "us-west-2", //lintignore:AWSAT003
+			"secret_key":                  "secret",
+			"skip_credentials_validation": true,
+			"skip_metadata_api_check":     "true",
+			"skip_region_validation":      true,
+			"skip_requesting_account_id":  true,
+		})
+	}
+
+	chain := []assumeRoleCall{
+		{RoleARN: "arn:aws:iam::111111111111:role/first", AccessKeyID: "AKIABASE"},
+		{RoleARN: "arn:aws:iam::222222222222:role/second", AccessKeyID: "ASIAFIRST"},
+		{RoleARN: "arn:aws:iam::333333333333:role/third", AccessKeyID: "ASIASECOND"},
+	}
+	otherChain := []assumeRoleCall{
+		{RoleARN: "arn:aws:iam::111111111111:role/first", AccessKeyID: "AKIAOTHER"},
+		{RoleARN: "arn:aws:iam::222222222222:role/second", AccessKeyID: "ASIAFIRST"},
+		{RoleARN: "arn:aws:iam::333333333333:role/third", AccessKeyID: "ASIASECOND"},
+	}
+	cacheDir := t.TempDir()
+
+	for _, testCase := range []struct {
+		Name          string
+		AccessKey     string
+		CacheDir      string
+		ExpectedCalls []assumeRoleCall
+	}{
+		{
+			Name:          "no cache",
+			AccessKey:     "AKIABASE",
+			ExpectedCalls: chain,
+		},
+		{
+			Name:          "cache miss",
+			AccessKey:     "AKIABASE",
+			CacheDir:      cacheDir,
+			ExpectedCalls: chain,
+		},
+		{
+			Name:      "cache hit",
+			AccessKey: "AKIABASE",
+			CacheDir:  cacheDir,
+		},
+		{
+			Name:          "cache other source credentials",
+			AccessKey:     "AKIAOTHER",
+			CacheDir:      cacheDir,
+			ExpectedCalls: otherChain,
+		},
+	} {
+		t.Run(testCase.Name, func(t *testing.T) {
+			meta, diags := configure(ctx, p, config(testCase.AccessKey, testCase.CacheDir))
+			if diags.HasError() {
+				t.Fatalf("configuring provider: %v", diags)
+			}
+
+			if diff := cmp.Diff(calls(), testCase.ExpectedCalls); diff != "" {
+				t.Errorf("unexpected sts:AssumeRole calls (-got +want): %s", diff)
+			}
+
+			credentials, err := meta.Session.Config.Credentials.GetWithContext(ctx)
+			if err != nil {
+				t.Fatalf("retrieving credentials: %s", err)
+			}
+
+			if got, want := credentials.AccessKeyID, "ASIATHIRD"; got != want {
+				t.Errorf("provider credentials access key = %s, want %s", got, want)
+			}
+		})
+	}
+}
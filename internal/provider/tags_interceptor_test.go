@@ -77,15 +77,12 @@ func TestTagsInterceptor(t *testing.T) {
 		DefaultTagsConfig: expandDefaultTags(context.Background(), map[string]interface{}{
 			"tag": "",
 		}),
-		IgnoreTagsConfig: expandIgnoreTags(context.Background(), map[string]interface{}{
-			"tag2": "tag",
-		}),
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
 		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
-			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig(ctx))
 		}
 
 		return ctx
@@ -89,7 +89,7 @@ func DataSourceDomainName() *schema.Resource {
 func dataSourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayConn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	domainName := d.Get("domain_name").(string)
 	output, err := FindDomainName(ctx, conn, domainName)
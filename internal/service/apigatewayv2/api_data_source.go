@@ -111,7 +111,7 @@ func DataSourceAPI() *schema.Resource {
 func dataSourceAPIRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayV2Conn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 	apiID := d.Get("api_id").(string)
 
 	api, err := FindAPIByID(ctx, conn, apiID)
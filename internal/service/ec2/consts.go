@@ -278,6 +278,9 @@ const (
 	securityGroupRuleTypeIngress = "ingress"
 )
 
+// securityGroupRuleManagedTagKey is the tag key that marks a security group rule as owned by a Terraform resource.
+const securityGroupRuleManagedTagKey = "terraform-provider-aws:managed"
+
 func securityGroupRuleType_Values() []string {
 	return []string{
 		securityGroupRuleTypeEgress,
@@ -294,3 +297,39 @@ const (
 	gatewayIDLocal      = "local"
 	gatewayIDVPCLattice = "VpcLattice"
 )
+
+const (
+	// https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_GetImageBlockPublicAccessState.html#API_GetImageBlockPublicAccessState_ResponseElements
+	imageBlockPublicAccessStateBlockNewSharing = "block-new-sharing"
+	imageBlockPublicAccessStateUnblocked       = "unblocked"
+)
+
+func imageBlockPublicAccessState_Values() []string {
+	return []string{
+		imageBlockPublicAccessStateBlockNewSharing,
+		imageBlockPublicAccessStateUnblocked,
+	}
+}
+
+const (
+	// https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_Image.html#API_Image_Contents
+	imageDeregistrationProtectionDisabled            = "disabled"
+	imageDeregistrationProtectionEnabled             = "enabled"
+	imageDeregistrationProtectionEnabledWithCooldown = "enabled-with-cooldown"
+)
+
+const (
+	// https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_ModifyInstanceMetadataDefaults.html#API_ModifyInstanceMetadataDefaults_RequestParameters
+	instanceMetadataDefaultsHTTPPutResponseHopLimitNoPreference = -1
+)
+
+const (
+	// https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateCapacityReservationFleet.html#API_CreateCapacityReservationFleet_RequestParameters
+	capacityReservationFleetAllocationStrategyPrioritized = "prioritized"
+)
+
+func capacityReservationFleetAllocationStrategy_Values() []string {
+	return []string{
+		capacityReservationFleetAllocationStrategyPrioritized,
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"strings"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -78,6 +81,17 @@ func ResourceAMI() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deregistration_protection_with_cooldown": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          false,
+				DiffSuppressFunc: suppressImageDeregistrationProtectionWithCooldownDiff,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -378,15 +392,21 @@ func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.Get("deregistration_protection").(bool) {
+		if err := enableImageDeregistrationProtection(ctx, meta.(*conns.AWSClient).EC2Client(ctx), d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s): %s", name, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
 func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
-		return FindImageByID(ctx, conn, d.Id())
+		return findImageByIDV2(ctx, conn, d.Id())
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -399,18 +419,22 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "reading EC2 AMI (%s): %s", d.Id(), err)
 	}
 
-	image := outputRaw.(*ec2.Image)
+	image := outputRaw.(*awstypes.Image)
 
-	if aws.StringValue(image.State) == ec2.ImageStatePending {
+	if image.State == awstypes.ImageStatePending {
 		// This could happen if a user manually adds an image we didn't create
 		// to the state. We'll wait for the image to become available
 		// before we continue. We should never take this branch in normal
 		// circumstances since we would've waited for availability during
 		// the "Create" step.
-		image, err = WaitImageAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+		if _, err := WaitImageAvailable(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 AMI (%s) create: %s", d.Id(), err)
+		}
+
+		image, err = findImageByIDV2(ctx, conn, d.Id())
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for EC2 AMI (%s) create: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "reading EC2 AMI (%s): %s", d.Id(), err)
 		}
 	}
 
@@ -425,6 +449,15 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("boot_mode", image.BootMode)
 	d.Set("description", image.Description)
 	d.Set("deprecation_time", image.DeprecationTime)
+	// e.g. "disabled", "enabled-with-cooldown", "enabled-without-cooldown".
+	// AMIs that have never had deregistration protection set, or in partitions that don't yet support it, return no value.
+	if deregistrationProtection := aws_sdkv2.ToString(image.DeregistrationProtection); strings.HasPrefix(deregistrationProtection, imageDeregistrationProtectionEnabled) {
+		d.Set("deregistration_protection", true)
+		d.Set("deregistration_protection_with_cooldown", deregistrationProtection == imageDeregistrationProtectionEnabledWithCooldown)
+	} else {
+		d.Set("deregistration_protection", false)
+		d.Set("deregistration_protection_with_cooldown", false)
+	}
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
 	d.Set("image_location", image.ImageLocation)
@@ -439,7 +472,7 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("public", image.Public)
 	d.Set("ramdisk_id", image.RamdiskId)
 	d.Set("root_device_name", image.RootDeviceName)
-	d.Set("root_snapshot_id", amiRootSnapshotId(image))
+	d.Set("root_snapshot_id", amiRootSnapshotIDV2(image))
 	d.Set("sriov_net_support", image.SriovNetSupport)
 	d.Set("tpm_support", image.TpmSupport)
 	d.Set("usage_operation", image.UsageOperation)
@@ -453,7 +486,7 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "setting ephemeral_block_device: %s", err)
 	}
 
-	setTagsOut(ctx, image.Tags)
+	setTagsOutV2(ctx, image.Tags)
 
 	return diags
 }
@@ -476,8 +509,28 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	if d.HasChange("deprecation_time") {
-		if err := enableImageDeprecation(ctx, conn, d.Id(), d.Get("deprecation_time").(string)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+		if v := d.Get("deprecation_time").(string); v != "" {
+			if err := enableImageDeprecation(ctx, conn, d.Id(), v); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := disableImageDeprecation(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChanges("deregistration_protection", "deregistration_protection_with_cooldown") {
+		conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+		if d.Get("deregistration_protection").(bool) {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		} else if d.HasChange("deregistration_protection") {
+			if err := disableImageDeregistrationProtection(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -551,6 +604,55 @@ func enableImageDeprecation(ctx context.Context, conn *ec2.EC2, id string, depre
 	return nil
 }
 
+func disableImageDeprecation(ctx context.Context, conn *ec2.EC2, id string) error {
+	input := &ec2.DisableImageDeprecationInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImageDeprecationWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling deprecation: %w", err)
+	}
+
+	return nil
+}
+
+// suppressImageDeregistrationProtectionWithCooldownDiff ignores the cooldown setting while deregistration protection is disabled,
+// as the API only reports it for protected AMIs.
+func suppressImageDeregistrationProtectionWithCooldownDiff(k, old, new string, d *schema.ResourceData) bool {
+	return !d.Get("deregistration_protection").(bool)
+}
+
+func enableImageDeregistrationProtection(ctx context.Context, conn *ec2_sdkv2.Client, id string, withCooldown bool) error {
+	input := &ec2_sdkv2.EnableImageDeregistrationProtectionInput{
+		ImageId:      aws_sdkv2.String(id),
+		WithCooldown: aws_sdkv2.Bool(withCooldown),
+	}
+
+	_, err := conn.EnableImageDeregistrationProtection(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: %w", err)
+	}
+
+	return nil
+}
+
+func disableImageDeregistrationProtection(ctx context.Context, conn *ec2_sdkv2.Client, id string) error {
+	input := &ec2_sdkv2.DisableImageDeregistrationProtectionInput{
+		ImageId: aws_sdkv2.String(id),
+	}
+
+	_, err := conn.DisableImageDeregistrationProtection(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling deregistration protection: %w", err)
+	}
+
+	return nil
+}
+
 func expandBlockDeviceMappingForAMIEBSBlockDevice(tfMap map[string]interface{}) *ec2.BlockDeviceMapping {
 	if tfMap == nil {
 		return nil
@@ -624,11 +726,7 @@ func expandBlockDeviceMappingsForAMIEBSBlockDevice(tfList []interface{}) []*ec2.
 	return apiObjects
 }
 
-func flattenBlockDeviceMappingForAMIEBSBlockDevice(apiObject *ec2.BlockDeviceMapping) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
+func flattenBlockDeviceMappingForAMIEBSBlockDevice(apiObject awstypes.BlockDeviceMapping) map[string]interface{} {
 	if apiObject.Ebs == nil {
 		return nil
 	}
@@ -636,45 +734,45 @@ func flattenBlockDeviceMappingForAMIEBSBlockDevice(apiObject *ec2.BlockDeviceMap
 	tfMap := map[string]interface{}{}
 
 	if v := apiObject.Ebs.DeleteOnTermination; v != nil {
-		tfMap["delete_on_termination"] = aws.BoolValue(v)
+		tfMap["delete_on_termination"] = aws_sdkv2.ToBool(v)
 	}
 
 	if v := apiObject.DeviceName; v != nil {
-		tfMap["device_name"] = aws.StringValue(v)
+		tfMap["device_name"] = aws_sdkv2.ToString(v)
 	}
 
 	if v := apiObject.Ebs.Encrypted; v != nil {
-		tfMap["encrypted"] = aws.BoolValue(v)
+		tfMap["encrypted"] = aws_sdkv2.ToBool(v)
 	}
 
 	if v := apiObject.Ebs.Iops; v != nil {
-		tfMap["iops"] = aws.Int64Value(v)
+		tfMap["iops"] = aws_sdkv2.ToInt32(v)
 	}
 
 	if v := apiObject.Ebs.SnapshotId; v != nil {
-		tfMap["snapshot_id"] = aws.StringValue(v)
+		tfMap["snapshot_id"] = aws_sdkv2.ToString(v)
 	}
 
 	if v := apiObject.Ebs.Throughput; v != nil {
-		tfMap["throughput"] = aws.Int64Value(v)
+		tfMap["throughput"] = aws_sdkv2.ToInt32(v)
 	}
 
 	if v := apiObject.Ebs.VolumeSize; v != nil {
-		tfMap["volume_size"] = aws.Int64Value(v)
+		tfMap["volume_size"] = aws_sdkv2.ToInt32(v)
 	}
 
-	if v := apiObject.Ebs.VolumeType; v != nil {
-		tfMap["volume_type"] = aws.StringValue(v)
+	if v := apiObject.Ebs.VolumeType; v != "" {
+		tfMap["volume_type"] = v
 	}
 
 	if v := apiObject.Ebs.OutpostArn; v != nil {
-		tfMap["outpost_arn"] = aws.StringValue(v)
+		tfMap["outpost_arn"] = aws_sdkv2.ToString(v)
 	}
 
 	return tfMap
 }
 
-func flattenBlockDeviceMappingsForAMIEBSBlockDevice(apiObjects []*ec2.BlockDeviceMapping) []interface{} {
+func flattenBlockDeviceMappingsForAMIEBSBlockDevice(apiObjects []awstypes.BlockDeviceMapping) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -682,10 +780,6 @@ func flattenBlockDeviceMappingsForAMIEBSBlockDevice(apiObjects []*ec2.BlockDevic
 	var tfList []interface{}
 
 	for _, apiObject := range apiObjects {
-		if apiObject == nil {
-			continue
-		}
-
 		if apiObject.Ebs == nil {
 			continue
 		}
@@ -740,25 +834,21 @@ func expandBlockDeviceMappingsForAMIEphemeralBlockDevice(tfList []interface{}) [
 	return apiObjects
 }
 
-func flattenBlockDeviceMappingForAMIEphemeralBlockDevice(apiObject *ec2.BlockDeviceMapping) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
+func flattenBlockDeviceMappingForAMIEphemeralBlockDevice(apiObject awstypes.BlockDeviceMapping) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
 	if v := apiObject.DeviceName; v != nil {
-		tfMap["device_name"] = aws.StringValue(v)
+		tfMap["device_name"] = aws_sdkv2.ToString(v)
 	}
 
 	if v := apiObject.VirtualName; v != nil {
-		tfMap["virtual_name"] = aws.StringValue(v)
+		tfMap["virtual_name"] = aws_sdkv2.ToString(v)
 	}
 
 	return tfMap
 }
 
-func flattenBlockDeviceMappingsForAMIEphemeralBlockDevice(apiObjects []*ec2.BlockDeviceMapping) []interface{} {
+func flattenBlockDeviceMappingsForAMIEphemeralBlockDevice(apiObjects []awstypes.BlockDeviceMapping) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -766,10 +856,6 @@ func flattenBlockDeviceMappingsForAMIEphemeralBlockDevice(apiObjects []*ec2.Bloc
 	var tfList []interface{}
 
 	for _, apiObject := range apiObjects {
-		if apiObject == nil {
-			continue
-		}
-
 		if apiObject.Ebs != nil {
 			continue
 		}
@@ -779,3 +865,21 @@ func flattenBlockDeviceMappingsForAMIEphemeralBlockDevice(apiObjects []*ec2.Bloc
 
 	return tfList
 }
+
+func amiRootSnapshotIDV2(image *awstypes.Image) string {
+	if image.RootDeviceName == nil {
+		return ""
+	}
+
+	for _, bdm := range image.BlockDeviceMappings {
+		if aws_sdkv2.ToString(bdm.DeviceName) != aws_sdkv2.ToString(image.RootDeviceName) {
+			continue
+		}
+
+		if bdm.Ebs != nil && bdm.Ebs.SnapshotId != nil {
+			return aws_sdkv2.ToString(bdm.Ebs.SnapshotId)
+		}
+	}
+
+	return ""
+}
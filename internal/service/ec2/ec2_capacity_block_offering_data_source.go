@@ -0,0 +1,130 @@
+package ec2
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_ec2_capacity_block_offering")
+func DataSourceCapacityBlockOffering() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCapacityBlockOfferingRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_block_offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_duration_hours": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"instance_count": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"tenancy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"upfront_fee": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCapacityBlockOfferingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2_sdkv2.DescribeCapacityBlockOfferingsInput{
+		CapacityDurationHours: aws_sdkv2.Int32(int32(d.Get("capacity_duration_hours").(int))),
+		InstanceCount:         aws_sdkv2.Int32(int32(d.Get("instance_count").(int))),
+		InstanceType:          aws_sdkv2.String(d.Get("instance_type").(string)),
+	}
+
+	if v, ok := d.GetOk("end_date_range"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.EndDateRange = aws_sdkv2.Time(v)
+	}
+
+	if v, ok := d.GetOk("start_date_range"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.StartDateRange = aws_sdkv2.Time(v)
+	}
+
+	offerings, err := FindCapacityBlockOfferings(ctx, conn, input)
+
+	if err == nil && len(offerings) == 0 {
+		err = tfresource.NewEmptyResultError(input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Capacity Block Offering", err))
+	}
+
+	// Use the offering with the earliest start date.
+	sort.SliceStable(offerings, func(i, j int) bool {
+		return aws_sdkv2.ToTime(offerings[i].StartDate).Before(aws_sdkv2.ToTime(offerings[j].StartDate))
+	})
+	offering := offerings[0]
+
+	d.SetId(aws_sdkv2.ToString(offering.CapacityBlockOfferingId))
+	d.Set("availability_zone", offering.AvailabilityZone)
+	d.Set("capacity_block_offering_id", offering.CapacityBlockOfferingId)
+	d.Set("currency_code", offering.CurrencyCode)
+	d.Set("end_date", aws_sdkv2.ToTime(offering.EndDate).Format(time.RFC3339))
+	d.Set("start_date", aws_sdkv2.ToTime(offering.StartDate).Format(time.RFC3339))
+	d.Set("tenancy", offering.Tenancy)
+	d.Set("upfront_fee", offering.UpfrontFee)
+
+	return diags
+}
@@ -0,0 +1,127 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+// @SDKResource("aws_ec2_default_credit_specification")
+func ResourceDefaultCreditSpecification() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDefaultCreditSpecificationCreate,
+		ReadWithoutTimeout:   resourceDefaultCreditSpecificationRead,
+		UpdateWithoutTimeout: resourceDefaultCreditSpecificationUpdate,
+		DeleteWithoutTimeout: resourceDefaultCreditSpecificationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cpu_credits": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(CPUCredits_Values(), false),
+			},
+			"instance_family": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.UnlimitedSupportedInstanceFamily](),
+			},
+		},
+	}
+}
+
+func resourceDefaultCreditSpecificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	instanceFamily := d.Get("instance_family").(string)
+	cpuCredits := d.Get("cpu_credits").(string)
+	if err := setDefaultCreditSpecification(ctx, conn, instanceFamily, cpuCredits, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("setting EC2 Default Credit Specification (%s): %s", instanceFamily, err)
+	}
+
+	d.SetId(instanceFamily)
+
+	return resourceDefaultCreditSpecificationRead(ctx, d, meta)
+}
+
+func resourceDefaultCreditSpecificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	output, err := FindDefaultCreditSpecificationByInstanceFamily(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Default Credit Specification (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cpu_credits", output.CpuCredits)
+	d.Set("instance_family", output.InstanceFamily)
+
+	return nil
+}
+
+func resourceDefaultCreditSpecificationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	cpuCredits := d.Get("cpu_credits").(string)
+	if err := setDefaultCreditSpecification(ctx, conn, d.Id(), cpuCredits, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("updating EC2 Default Credit Specification (%s): %s", d.Id(), err)
+	}
+
+	return resourceDefaultCreditSpecificationRead(ctx, d, meta)
+}
+
+func resourceDefaultCreditSpecificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Removing the resource restores the instance family's AWS default credit option.
+	cpuCredits := defaultCreditSpecificationCPUCredits(d.Id())
+	if err := setDefaultCreditSpecification(ctx, conn, d.Id(), cpuCredits, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("resetting EC2 Default Credit Specification (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func setDefaultCreditSpecification(ctx context.Context, conn *ec2.Client, instanceFamily, cpuCredits string, timeout time.Duration) error {
+	_, err := conn.ModifyDefaultCreditSpecification(ctx, &ec2.ModifyDefaultCreditSpecificationInput{
+		CpuCredits:     aws.String(cpuCredits),
+		InstanceFamily: types.UnlimitedSupportedInstanceFamily(instanceFamily),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// The new default can take up to 5 minutes to take effect.
+	_, err = WaitDefaultCreditSpecificationUpdated(ctx, conn, instanceFamily, cpuCredits, timeout)
+
+	return err
+}
+
+// defaultCreditSpecificationCPUCredits returns the credit option an instance family launches with when no account-level default is set.
+// See https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/burstable-performance-instances-how-to.html.
+func defaultCreditSpecificationCPUCredits(instanceFamily string) string {
+	if types.UnlimitedSupportedInstanceFamily(instanceFamily) == types.UnlimitedSupportedInstanceFamilyT2 {
+		return CPUCreditsStandard
+	}
+
+	return CPUCreditsUnlimited
+}
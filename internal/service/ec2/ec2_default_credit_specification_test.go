@@ -0,0 +1,110 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2DefaultCreditSpecification_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_default_credit_specification.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultCreditSpecificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultCreditSpecificationConfig_basic("t2", "unlimited"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultCreditSpecification(ctx, resourceName, "unlimited"),
+					resource.TestCheckResourceAttr(resourceName, "cpu_credits", "unlimited"),
+					resource.TestCheckResourceAttr(resourceName, "instance_family", "t2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDefaultCreditSpecificationConfig_basic("t2", "standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultCreditSpecification(ctx, resourceName, "standard"),
+					resource.TestCheckResourceAttr(resourceName, "cpu_credits", "standard"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDefaultCreditSpecificationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_default_credit_specification" {
+				continue
+			}
+
+			output, err := tfec2.FindDefaultCreditSpecificationByInstanceFamily(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			// t2 instances launch as standard by default.
+			if v := aws.ToString(output.CpuCredits); v != "standard" {
+				return fmt.Errorf("EC2 Default Credit Specification (%s) not reset on resource removal: %s", rs.Primary.ID, v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDefaultCreditSpecification(ctx context.Context, n, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindDefaultCreditSpecificationByInstanceFamily(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if v := aws.ToString(output.CpuCredits); v != expected {
+			return fmt.Errorf("EC2 Default Credit Specification (%s) is not in expected state (%s): %s", rs.Primary.ID, expected, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccDefaultCreditSpecificationConfig_basic(instanceFamily, cpuCredits string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_default_credit_specification" "test" {
+  instance_family = %[1]q
+  cpu_credits     = %[2]q
+}
+`, instanceFamily, cpuCredits)
+}
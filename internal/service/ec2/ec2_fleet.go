@@ -46,6 +46,16 @@ func ResourceFleet() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			resourceFleetCustomizeDiff,
 			verify.SetTagsDiff,
+			// Only EC2 Fleets of type maintain can be modified.
+			customdiff.ForceNewIf("context", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("context") && diff.Get("type").(string) != ec2.FleetTypeMaintain
+			}),
+			customdiff.ForceNewIf("launch_template_config", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("launch_template_config") && diff.Get("type").(string) != ec2.FleetTypeMaintain
+			}),
+			customdiff.ForceNewIf("target_capacity_specification.0.total_target_capacity", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("target_capacity_specification.0.total_target_capacity") && diff.Get("type").(string) != ec2.FleetTypeMaintain
+			}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -569,6 +579,7 @@ func ResourceFleet() *schema.Resource {
 												"termination_delay": {
 													Type:         schema.TypeInt,
 													Optional:     true,
+													ForceNew:     true,
 													ValidateFunc: validation.IntBetween(120, 7200),
 												},
 											},
@@ -942,6 +953,31 @@ func resourceFleetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v
 		}
 	}
 
+	return validCapacityRebalanceTerminationDelay(diff, "spot_options.0.maintenance_strategies.0.capacity_rebalance.0")
+}
+
+// capacityRebalanceDiffer is the subset of schema.ResourceDiff used to validate capacity rebalance settings.
+type capacityRebalanceDiffer interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+	NewValueKnown(string) bool
+}
+
+// validCapacityRebalanceTerminationDelay returns an error if the capacity rebalance configuration block
+// at the specified path sets termination_delay without the launch-before-terminate replacement strategy.
+func validCapacityRebalanceTerminationDelay(diff capacityRebalanceDiffer, path string) error {
+	if v, ok := diff.GetOk(path + ".termination_delay"); !ok || v.(int) == 0 {
+		return nil
+	}
+
+	if !diff.NewValueKnown(path + ".replacement_strategy") {
+		return nil
+	}
+
+	if v := diff.Get(path + ".replacement_strategy").(string); v != ec2.FleetReplacementStrategyLaunchBeforeTerminate {
+		return fmt.Errorf(`"termination_delay" can only be set when "replacement_strategy" is %q`, ec2.FleetReplacementStrategyLaunchBeforeTerminate)
+	}
+
 	return nil
 }
 
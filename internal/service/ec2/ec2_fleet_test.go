@@ -2700,6 +2700,24 @@ func TestAccEC2Fleet_capacityRebalanceInvalidType(t *testing.T) {
 	})
 }
 
+func TestAccEC2Fleet_capacityRebalanceInvalidTerminationDelay(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_spotOptionsCapacityRebalance(rName, "diversified", "launch", "120"),
+				ExpectError: regexp.MustCompile(`"termination_delay" can only be set when "replacement_strategy" is "launch-before-terminate"`),
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_SpotOptions_instanceInterruptionBehavior(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet1, fleet2 ec2.FleetData
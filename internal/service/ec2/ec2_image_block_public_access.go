@@ -0,0 +1,117 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ec2_image_block_public_access")
+func ResourceImageBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceImageBlockPublicAccessCreate,
+		ReadWithoutTimeout:   resourceImageBlockPublicAccessRead,
+		UpdateWithoutTimeout: resourceImageBlockPublicAccessUpdate,
+		DeleteWithoutTimeout: resourceImageBlockPublicAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(imageBlockPublicAccessState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceImageBlockPublicAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	state := d.Get("state").(string)
+	if err := setImageBlockPublicAccessState(ctx, conn, state, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("setting EC2 Image Block Public Access (%s): %s", state, err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return resourceImageBlockPublicAccessRead(ctx, d, meta)
+}
+
+func resourceImageBlockPublicAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	state, err := FindImageBlockPublicAccessState(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Image Block Public Access (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Image Block Public Access (%s): %s", d.Id(), err)
+	}
+
+	d.Set("state", state)
+
+	return nil
+}
+
+func resourceImageBlockPublicAccessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	state := d.Get("state").(string)
+	if err := setImageBlockPublicAccessState(ctx, conn, state, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("updating EC2 Image Block Public Access (%s): %s", state, err)
+	}
+
+	return resourceImageBlockPublicAccessRead(ctx, d, meta)
+}
+
+func resourceImageBlockPublicAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Removing the resource unblocks public sharing of AMIs.
+	if err := setImageBlockPublicAccessState(ctx, conn, imageBlockPublicAccessStateUnblocked, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("disabling EC2 Image Block Public Access: %s", err)
+	}
+
+	return nil
+}
+
+func setImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client, state string, timeout time.Duration) error {
+	var err error
+
+	if state == imageBlockPublicAccessStateUnblocked {
+		_, err = conn.DisableImageBlockPublicAccess(ctx, &ec2.DisableImageBlockPublicAccessInput{})
+	} else {
+		_, err = conn.EnableImageBlockPublicAccess(ctx, &ec2.EnableImageBlockPublicAccessInput{
+			ImageBlockPublicAccessState: types.ImageBlockPublicAccessEnabledState(state),
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// The state change can take up to 10 minutes to take effect.
+	return WaitImageBlockPublicAccessStateUpdated(ctx, conn, state, timeout)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_instance", name="Instance")
@@ -896,10 +897,86 @@ func ResourceInstance() *schema.Resource {
 			customdiff.ForceNewIf("user_data_base64", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
+			resourceInstanceCustomizeDiffPreflight,
 		),
 	}
 }
 
+func resourceInstanceCustomizeDiffPreflight(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("ami", "availability_zone", "instance_type", "subnet_id") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	return validateInstanceLaunchPreflight(ctx, conn, diff.Get("ami").(string), diff.Get("instance_type").(string), diff.Get("availability_zone").(string), diff.Get("subnet_id").(string))
+}
+
+// validateInstanceLaunchPreflight checks at plan time that an instance could be launched with the specified
+// AMI and instance type into the specified Availability Zone or subnet.
+// Empty (unknown or unset) values are skipped, as are checks whose lookups fail (e.g. missing IAM permissions).
+func validateInstanceLaunchPreflight(ctx context.Context, conn *ec2.EC2, imageID, instanceType, availabilityZone, subnetID string) error {
+	if instanceType == "" {
+		return nil
+	}
+
+	instanceTypeInfo, err := FindInstanceTypeByName(ctx, conn, instanceType)
+
+	if err != nil {
+		log.Printf("[WARN] Skipping launch preflight checks, reading EC2 Instance Type (%s): %s", instanceType, err)
+		return nil
+	}
+
+	if subnetID != "" {
+		subnet, err := FindSubnetByID(ctx, conn, subnetID)
+
+		if err != nil {
+			log.Printf("[WARN] Skipping launch preflight subnet checks, reading EC2 Subnet (%s): %s", subnetID, err)
+		} else {
+			availabilityZone = aws.StringValue(subnet.AvailabilityZone)
+
+			if aws.BoolValue(subnet.Ipv6Native) && aws.StringValue(instanceTypeInfo.Hypervisor) != ec2.InstanceTypeHypervisorNitro && !aws.BoolValue(instanceTypeInfo.BareMetal) {
+				return fmt.Errorf("EC2 Subnet (%s) is IPv6-only and instance type (%s) is not built on the Nitro System", subnetID, instanceType)
+			}
+		}
+	}
+
+	if availabilityZone != "" {
+		input := &ec2.DescribeInstanceTypeOfferingsInput{
+			Filters: BuildAttributeFilterList(map[string]string{
+				"instance-type": instanceType,
+				"location":      availabilityZone,
+			}),
+			LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+		}
+
+		output, err := FindInstanceTypeOfferings(ctx, conn, input)
+
+		if err != nil {
+			log.Printf("[WARN] Skipping launch preflight offering check, reading EC2 Instance Type Offerings (%s): %s", instanceType, err)
+		} else if len(output) == 0 {
+			return fmt.Errorf("instance type (%s) is not offered in Availability Zone (%s)", instanceType, availabilityZone)
+		}
+	}
+
+	if imageID != "" {
+		image, err := FindImageByID(ctx, conn, imageID)
+
+		if err != nil {
+			log.Printf("[WARN] Skipping launch preflight architecture check, reading EC2 AMI (%s): %s", imageID, err)
+		} else if instanceTypeInfo.ProcessorInfo != nil {
+			architecture := aws.StringValue(image.Architecture)
+			supported := aws.StringValueSlice(instanceTypeInfo.ProcessorInfo.SupportedArchitectures)
+
+			if !slices.Contains(supported, architecture) {
+				return fmt.Errorf("EC2 AMI (%s) architecture (%s) is not supported by instance type (%s), supported architectures: %s", imageID, architecture, instanceType, strings.Join(supported, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
 func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	// Suppress diff if volume_type is not io1, io2, or gp3 and iops is unset or configured as 0
 	i := strings.LastIndexByte(k, '.')
@@ -969,10 +969,36 @@ func ResourceLaunchTemplate() *schema.Resource {
 				return false
 			}),
 			verify.SetTagsDiff,
+			resourceLaunchTemplateCustomizeDiffPreflight,
 		),
 	}
 }
 
+func resourceLaunchTemplateCustomizeDiffPreflight(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("image_id", "instance_type", "network_interfaces", "placement") {
+		return nil
+	}
+
+	var subnetID string
+
+	for _, v := range diff.Get("network_interfaces").([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["device_index"].(int); ok && v == 0 {
+			subnetID, _ = tfMap["subnet_id"].(string)
+			break
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	return validateInstanceLaunchPreflight(ctx, conn, diff.Get("image_id").(string), diff.Get("instance_type").(string), diff.Get("placement.0.availability_zone").(string), subnetID)
+}
+
 func resourceLaunchTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
@@ -162,6 +162,24 @@ func TestAccEC2LaunchTemplate_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_Preflight_architectureMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLaunchTemplateConfig_preflightArchitectureMismatch(rName),
+				ExpectError: regexp.MustCompile(`architecture \(arm64\) is not supported by instance type`),
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_BlockDeviceMappings_ebs(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template ec2.LaunchTemplate
@@ -3244,6 +3262,16 @@ resource "aws_launch_template" "test" {}
 `
 }
 
+func testAccLaunchTemplateConfig_preflightArchitectureMismatch(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinux2HVMEBSARM64AMI(), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-arm64.id
+  instance_type = "t3.micro"
+}
+`, rName))
+}
+
 func testAccLaunchTemplateConfig_namePrefix(namePrefix string) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -70,7 +70,7 @@ func DataSourcePublicIPv4Pool() *schema.Resource {
 func dataSourcePublicIPv4PoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	poolID := d.Get("pool_id").(string)
 	pool, err := FindPublicIPv4PoolByID(ctx, conn, poolID)
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -67,7 +68,6 @@ func ResourceSpotFleetRequest() *schema.Resource {
 			"context": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			// Provided constants do not have the correct casing so going with hard-coded values.
@@ -360,7 +360,6 @@ func ResourceSpotFleetRequest() *schema.Resource {
 			"launch_template_config": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"launch_template_specification": {
@@ -372,19 +371,16 @@ func ResourceSpotFleetRequest() *schema.Resource {
 									"id": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ForceNew:     true,
 										ValidateFunc: verify.ValidLaunchTemplateID,
 									},
 									"name": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ForceNew:     true,
 										ValidateFunc: verify.ValidLaunchTemplateName,
 									},
 									"version": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.StringLenBetween(1, 255),
 									},
 								},
@@ -393,38 +389,32 @@ func ResourceSpotFleetRequest() *schema.Resource {
 						"overrides": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							ForceNew: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"availability_zone": {
 										Type:     schema.TypeString,
 										Optional: true,
-										ForceNew: true,
 									},
 									"instance_requirements": {
 										Type:     schema.TypeList,
 										Optional: true,
-										ForceNew: true,
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"accelerator_count": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"max": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(0),
 															},
 															"min": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -433,7 +423,6 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"accelerator_manufacturers": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:         schema.TypeString,
 														ValidateFunc: validation.StringInSlice(ec2.AcceleratorManufacturer_Values(), false),
@@ -442,7 +431,6 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"accelerator_names": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:         schema.TypeString,
 														ValidateFunc: validation.StringInSlice(ec2.AcceleratorName_Values(), false),
@@ -451,20 +439,17 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"accelerator_total_memory_mib": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"max": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 															"min": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -473,7 +458,6 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"accelerator_types": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:         schema.TypeString,
 														ValidateFunc: validation.StringInSlice(ec2.AcceleratorType_Values(), false),
@@ -482,33 +466,28 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"allowed_instance_types": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 400,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
 												"bare_metal": {
 													Type:         schema.TypeString,
 													Optional:     true,
-													ForceNew:     true,
 													ValidateFunc: validation.StringInSlice(ec2.BareMetal_Values(), false),
 												},
 												"baseline_ebs_bandwidth_mbps": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"max": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 															"min": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -517,13 +496,11 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"burstable_performance": {
 													Type:         schema.TypeString,
 													Optional:     true,
-													ForceNew:     true,
 													ValidateFunc: validation.StringInSlice(ec2.BurstablePerformance_Values(), false),
 												},
 												"cpu_manufacturers": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:         schema.TypeString,
 														ValidateFunc: validation.StringInSlice(ec2.CpuManufacturer_Values(), false),
@@ -532,14 +509,12 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"excluded_instance_types": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 400,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
 												"instance_generations": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:         schema.TypeString,
 														ValidateFunc: validation.StringInSlice(ec2.InstanceGeneration_Values(), false),
@@ -548,13 +523,11 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"local_storage": {
 													Type:         schema.TypeString,
 													Optional:     true,
-													ForceNew:     true,
 													ValidateFunc: validation.StringInSlice(ec2.LocalStorage_Values(), false),
 												},
 												"local_storage_types": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:         schema.TypeString,
 														ValidateFunc: validation.StringInSlice(ec2.LocalStorageType_Values(), false),
@@ -563,20 +536,17 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"memory_gib_per_vcpu": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"max": {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 															"min": {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 														},
@@ -585,20 +555,17 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"memory_mib": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"max": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 															"min": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -607,20 +574,17 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"network_bandwidth_gbps": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"max": {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 															"min": {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 														},
@@ -629,20 +593,17 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"network_interface_count": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"max": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 															"min": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -651,37 +612,31 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"on_demand_max_price_percentage_over_lowest_price": {
 													Type:         schema.TypeInt,
 													Optional:     true,
-													ForceNew:     true,
 													ValidateFunc: validation.IntAtLeast(1),
 												},
 												"require_hibernate_support": {
 													Type:     schema.TypeBool,
 													Optional: true,
-													ForceNew: true,
 												},
 												"spot_max_price_percentage_over_lowest_price": {
 													Type:         schema.TypeInt,
 													Optional:     true,
-													ForceNew:     true,
 													ValidateFunc: validation.IntAtLeast(1),
 												},
 												"total_local_storage_gb": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"max": {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 															"min": {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 														},
@@ -690,20 +645,17 @@ func ResourceSpotFleetRequest() *schema.Resource {
 												"vcpu_count": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"max": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 															"min": {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -715,31 +667,26 @@ func ResourceSpotFleetRequest() *schema.Resource {
 									"instance_type": {
 										Type:     schema.TypeString,
 										Optional: true,
-										ForceNew: true,
 									},
 									"priority": {
 										Type:     schema.TypeFloat,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									"spot_price": {
 										Type:     schema.TypeString,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									"subnet_id": {
 										Type:     schema.TypeString,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									"weighted_capacity": {
 										Type:     schema.TypeFloat,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 								},
 							},
@@ -797,6 +744,13 @@ func ResourceSpotFleetRequest() *schema.Resource {
 										ForceNew:     true,
 										ValidateFunc: validation.StringInSlice(ec2.ReplacementStrategy_Values(), false),
 									},
+									// ModifySpotFleetRequest can't change maintenance strategies, whatever the fleet type.
+									"termination_delay": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(120, 7200),
+									},
 								},
 							},
 						},
@@ -863,10 +817,32 @@ func ResourceSpotFleetRequest() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.ForceNewIf("context", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return spotFleetRequestChangeRequiresReplacement(diff, "context")
+			}),
+			customdiff.ForceNewIf("launch_template_config", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return spotFleetRequestChangeRequiresReplacement(diff, "launch_template_config")
+			}),
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return validCapacityRebalanceTerminationDelay(diff, "spot_maintenance_strategies.0.capacity_rebalance.0")
+			},
+		),
 	}
 }
 
+type spotFleetRequestDiffer interface {
+	Get(string) interface{}
+	HasChange(string) bool
+}
+
+// spotFleetRequestChangeRequiresReplacement returns whether a change to the specified attribute requires replacement.
+// Only Spot Fleet requests of type maintain can be modified.
+func spotFleetRequestChangeRequiresReplacement(diff spotFleetRequestDiffer, key string) bool {
+	return diff.HasChange(key) && diff.Get("fleet_type").(string) != ec2.FleetTypeMaintain
+}
+
 func resourceSpotFleetRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
@@ -1129,6 +1105,14 @@ func resourceSpotFleetRequestUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
+		if d.HasChange("context") {
+			input.Context = aws.String(d.Get("context").(string))
+		}
+
+		if d.HasChange("launch_template_config") {
+			input.LaunchTemplateConfigs = expandLaunchTemplateConfigs(d.Get("launch_template_config").(*schema.Set).List())
+		}
+
 		log.Printf("[DEBUG] Modifying EC2 Spot Fleet Request: %s", input)
 		if _, err := conn.ModifySpotFleetRequestWithContext(ctx, input); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Spot Fleet Request (%s): %s", d.Id(), err)
@@ -1857,6 +1841,10 @@ func expandSpotCapacityRebalance(l []interface{}) *ec2.SpotCapacityRebalance {
 		capacityRebalance.ReplacementStrategy = aws.String(v.(string))
 	}
 
+	if v, ok := m["termination_delay"]; ok && v.(int) != 0 {
+		capacityRebalance.TerminationDelay = aws.Int64(int64(v.(int)))
+	}
+
 	return capacityRebalance
 }
 
@@ -2246,6 +2234,7 @@ func flattenSpotCapacityRebalance(spotCapacityRebalance *ec2.SpotCapacityRebalan
 
 	m := map[string]interface{}{
 		"replacement_strategy": aws.StringValue(spotCapacityRebalance.ReplacementStrategy),
+		"termination_delay":    aws.Int64Value(spotCapacityRebalance.TerminationDelay),
 	}
 
 	return []interface{}{m}
@@ -20,6 +20,132 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+type mockSpotFleetRequestDiffer struct {
+	changed map[string]bool
+	unknown map[string]bool
+	values  map[string]interface{}
+}
+
+func (d *mockSpotFleetRequestDiffer) Get(key string) interface{} {
+	return d.values[key]
+}
+
+func (d *mockSpotFleetRequestDiffer) GetOk(key string) (interface{}, bool) {
+	v, ok := d.values[key]
+	return v, ok && v != 0 && v != ""
+}
+
+func (d *mockSpotFleetRequestDiffer) HasChange(key string) bool {
+	return d.changed[key]
+}
+
+func (d *mockSpotFleetRequestDiffer) NewValueKnown(key string) bool {
+	return !d.unknown[key]
+}
+
+func TestSpotFleetRequestChangeRequiresReplacement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fleetType string
+		changed   bool
+		expected  bool
+	}{
+		"maintain, changed": {
+			fleetType: ec2.FleetTypeMaintain,
+			changed:   true,
+			expected:  false,
+		},
+		"maintain, unchanged": {
+			fleetType: ec2.FleetTypeMaintain,
+		},
+		"request, changed": {
+			fleetType: ec2.FleetTypeRequest,
+			changed:   true,
+			expected:  true,
+		},
+		"request, unchanged": {
+			fleetType: ec2.FleetTypeRequest,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, key := range []string{"context", "launch_template_config"} {
+				diff := &mockSpotFleetRequestDiffer{
+					changed: map[string]bool{key: testCase.changed},
+					values:  map[string]interface{}{"fleet_type": testCase.fleetType},
+				}
+
+				if got := tfec2.SpotFleetRequestChangeRequiresReplacement(diff, key); got != testCase.expected {
+					t.Errorf("%s: got %t, expected %t", key, got, testCase.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestValidCapacityRebalanceTerminationDelay(t *testing.T) {
+	t.Parallel()
+
+	const path = "spot_maintenance_strategies.0.capacity_rebalance.0"
+
+	testCases := map[string]struct {
+		replacementStrategy string
+		strategyUnknown     bool
+		terminationDelay    int
+		expectError         bool
+	}{
+		"no termination delay": {
+			replacementStrategy: ec2.ReplacementStrategyLaunch,
+		},
+		"launch-before-terminate": {
+			replacementStrategy: ec2.ReplacementStrategyLaunchBeforeTerminate,
+			terminationDelay:    120,
+		},
+		"launch": {
+			replacementStrategy: ec2.ReplacementStrategyLaunch,
+			terminationDelay:    120,
+			expectError:         true,
+		},
+		"no replacement strategy": {
+			terminationDelay: 120,
+			expectError:      true,
+		},
+		"unknown replacement strategy": {
+			strategyUnknown:  true,
+			terminationDelay: 120,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diff := &mockSpotFleetRequestDiffer{
+				unknown: map[string]bool{path + ".replacement_strategy": testCase.strategyUnknown},
+				values: map[string]interface{}{
+					path + ".replacement_strategy": testCase.replacementStrategy,
+					path + ".termination_delay":    testCase.terminationDelay,
+				},
+			}
+
+			err := tfec2.ValidCapacityRebalanceTerminationDelay(diff, path)
+
+			if testCase.expectError && err == nil {
+				t.Error("expected error, got none")
+			}
+			if !testCase.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccEC2SpotFleetRequest_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr ec2.SpotFleetRequestConfig
@@ -288,6 +414,49 @@ func TestAccEC2SpotFleetRequest_launchTemplate(t *testing.T) {
 	})
 }
 
+func TestAccEC2SpotFleetRequest_LaunchTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after ec2.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateOverrideInstanceType(rName, publicKey, validUntil, "t3.micro"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_type": "t3.micro",
+					}),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateOverrideInstanceType(rName, publicKey, validUntil, "t3.small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestNotRecreatedConfig(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_type": "t3.small",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2SpotFleetRequest_LaunchTemplate_multiple(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr ec2.SpotFleetRequestConfig
@@ -1696,6 +1865,66 @@ func TestAccEC2SpotFleetRequest_capacityRebalance(t *testing.T) {
 	})
 }
 
+func TestAccEC2SpotFleetRequest_capacityRebalanceTerminationDelay(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr ec2.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_capacityRebalanceTerminationDelay(rName, publicKey, validUntil, "launch-before-terminate", 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_maintenance_strategies.0.capacity_rebalance.0.replacement_strategy", "launch-before-terminate"),
+					resource.TestCheckResourceAttr(resourceName, "spot_maintenance_strategies.0.capacity_rebalance.0.termination_delay", "120"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_capacityRebalanceInvalidTerminationDelay(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_capacityRebalanceTerminationDelay(rName, publicKey, validUntil, "launch", 120),
+				ExpectError: regexp.MustCompile(`"termination_delay" can only be set when "replacement_strategy" is "launch-before-terminate"`),
+			},
+		},
+	})
+}
+
 func TestAccEC2SpotFleetRequest_instanceStoreAMI(t *testing.T) {
 	ctx := acctest.Context(t)
 	var config ec2.SpotFleetRequestConfig
@@ -1780,6 +2009,16 @@ func testAccCheckSpotFleetRequestRecreatedConfig(t *testing.T,
 	}
 }
 
+func testAccCheckSpotFleetRequestNotRecreatedConfig(t *testing.T,
+	before, after *ec2.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.SpotFleetRequestId != after.SpotFleetRequestId {
+			t.Fatalf("Expected no change of Spot Fleet Request IDs, but got %v and %v", before.SpotFleetRequestId, after.SpotFleetRequestId)
+		}
+		return nil
+	}
+}
+
 func testAccCheckSpotFleetRequestExists(ctx context.Context, n string, v *ec2.SpotFleetRequestConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -2317,6 +2556,48 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_launchTemplateOverrideInstanceType(rName, publicKey, validUntil, instanceType string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  key_name      = aws_key_pair.test.key_name
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.05"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  instance_interruption_behaviour     = "stop"
+  wait_for_fulfillment                = true
+
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
+
+    overrides {
+      instance_type = %[3]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, instanceType))
+}
+
 func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverrides(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -3397,6 +3678,38 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_capacityRebalanceTerminationDelay(rName, publicKey, validUntil, replacementStrategy string, terminationDelay int) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.05"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  spot_maintenance_strategies {
+    capacity_rebalance {
+      replacement_strategy = %[3]q
+      termination_delay    = %[4]d
+    }
+  }
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, replacementStrategy, terminationDelay))
+}
+
 func testAccSpotFleetRequestConfig_onDemandTargetCapacity(rName, publicKey, validUntil string, targetCapacity int) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_launch_template" "test" {
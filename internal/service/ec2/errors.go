@@ -24,6 +24,7 @@ const (
 	errCodeInvalidAllocationIDNotFound                       = "InvalidAllocationID.NotFound"
 	errCodeInvalidAssociationIDNotFound                      = "InvalidAssociationID.NotFound"
 	errCodeInvalidAttachmentIDNotFound                       = "InvalidAttachmentID.NotFound"
+	errCodeInvalidCapacityReservationFleetIDNotFound         = "InvalidCapacityReservationFleetId.NotFound"
 	errCodeInvalidCapacityReservationIdNotFound              = "InvalidCapacityReservationId.NotFound'"
 	errCodeInvalidCarrierGatewayIDNotFound                   = "InvalidCarrierGatewayID.NotFound"
 	errCodeInvalidClientVPNActiveAssociationNotFound         = "InvalidClientVpnActiveAssociationNotFound"
@@ -73,6 +74,9 @@ const (
 	errCodeInvalidPrefixListIdNotFound                       = "InvalidPrefixListId.NotFound"
 	errCodeInvalidPublicIpv4PoolIDNotFound                   = "InvalidPublicIpv4PoolID.NotFound" // nosemgrep:ci.caps5-in-const-name,ci.caps5-in-var-name
 	errCodeInvalidRouteNotFound                              = "InvalidRoute.NotFound"
+	errCodeInvalidRouteServerEndpointIdNotFound              = "InvalidRouteServerEndpointId.NotFound"
+	errCodeInvalidRouteServerIdNotFound                      = "InvalidRouteServerId.NotFound"
+	errCodeInvalidRouteServerPeerIdNotFound                  = "InvalidRouteServerPeerId.NotFound"
 	errCodeInvalidRouteTableIDNotFound                       = "InvalidRouteTableID.NotFound"
 	errCodeInvalidRouteTableIdNotFound                       = "InvalidRouteTableId.NotFound"
 	errCodeInvalidSecurityGroupIDNotFound                    = "InvalidSecurityGroupID.NotFound"
@@ -96,6 +100,11 @@ const (
 	errCodeInvalidTransitGatewayIDNotFound                   = "InvalidTransitGatewayID.NotFound"
 	errCodeInvalidTransitGatewayMulticastDomainIdNotFound    = "InvalidTransitGatewayMulticastDomainId.NotFound"
 	errCodeInvalidVolumeNotFound                             = "InvalidVolume.NotFound"
+	errCodeInvalidVerifiedAccessEndpointIdNotFound           = "InvalidVerifiedAccessEndpointId.NotFound"
+	errCodeInvalidVerifiedAccessGroupIdNotFound              = "InvalidVerifiedAccessGroupId.NotFound"
+	errCodeInvalidVerifiedAccessInstanceIdNotFound           = "InvalidVerifiedAccessInstanceId.NotFound"
+	errCodeInvalidVerifiedAccessTrustProviderIdNotFound      = "InvalidVerifiedAccessTrustProviderId.NotFound"
+	errCodeInvalidVPCBlockPublicAccessExclusionIdNotFound    = "InvalidVpcBlockPublicAccessExclusionId.NotFound"
 	errCodeInvalidVPCCIDRBlockAssociationIDNotFound          = "InvalidVpcCidrBlockAssociationID.NotFound"
 	errCodeInvalidVPCEndpointIdNotFound                      = "InvalidVpcEndpointId.NotFound"
 	errCodeInvalidVPCEndpointNotFound                        = "InvalidVpcEndpoint.NotFound"
@@ -116,6 +125,26 @@ const (
 	errCodeVPNGatewayLimitExceeded                           = "VpnGatewayLimitExceeded"
 )
 
+func CancelCapacityReservationFleetError(apiObject *ec2.FailedCapacityReservationFleetCancellationResult) error {
+	if apiObject == nil || apiObject.CancelCapacityReservationFleetError == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.CancelCapacityReservationFleetError.Code), aws.StringValue(apiObject.CancelCapacityReservationFleetError.Message), nil)
+}
+
+func CancelCapacityReservationFleetsError(apiObjects []*ec2.FailedCapacityReservationFleetCancellationResult) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := CancelCapacityReservationFleetError(apiObject); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(apiObject.CapacityReservationFleetId), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func CancelSpotFleetRequestError(apiObject *ec2.CancelSpotFleetRequestsErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
@@ -156,6 +185,66 @@ func DeleteFleetsError(apiObjects []*ec2.DeleteFleetErrorItem) error {
 	return errors.ErrorOrNil()
 }
 
+func DisableFastSnapshotRestoreError(apiObject *ec2.DisableFastSnapshotRestoreErrorItem) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	var errors *multierror.Error
+
+	for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+		if v == nil || v.Error == nil {
+			continue
+		}
+
+		errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(v.AvailabilityZone), awserr.New(aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message), nil)))
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func DisableFastSnapshotRestoresError(apiObjects []*ec2.DisableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := DisableFastSnapshotRestoreError(apiObject); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(apiObject.SnapshotId), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func EnableFastSnapshotRestoreError(apiObject *ec2.EnableFastSnapshotRestoreErrorItem) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	var errors *multierror.Error
+
+	for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+		if v == nil || v.Error == nil {
+			continue
+		}
+
+		errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(v.AvailabilityZone), awserr.New(aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message), nil)))
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func EnableFastSnapshotRestoresError(apiObjects []*ec2.EnableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := EnableFastSnapshotRestoreError(apiObject); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(apiObject.SnapshotId), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func UnsuccessfulItemError(apiObject *ec2.UnsuccessfulItemError) error {
 	if apiObject == nil {
 		return nil
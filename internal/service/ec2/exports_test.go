@@ -2,9 +2,21 @@ package ec2
 
 // Exports for use in tests only.
 var (
-	ResourceInstanceConnectEndpoint  = newResourceInstanceConnectEndpoint
-	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
-	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
+	ResourceInstanceConnectEndpoint                       = newResourceInstanceConnectEndpoint
+	ResourceSecurityGroupEgressRule                       = newResourceSecurityGroupEgressRule
+	ResourceSecurityGroupIngressRule                      = newResourceSecurityGroupIngressRule
+	ResourceVPCBlockPublicAccessExclusion                 = newResourceVPCBlockPublicAccessExclusion
+	ResourceVPCBlockPublicAccessOptions                   = newResourceVPCBlockPublicAccessOptions
+	ResourceVerifiedAccessEndpoint                        = newResourceVerifiedAccessEndpoint
+	ResourceVerifiedAccessGroup                           = newResourceVerifiedAccessGroup
+	ResourceVerifiedAccessInstance                        = newResourceVerifiedAccessInstance
+	ResourceVerifiedAccessInstanceLoggingConfiguration    = newResourceVerifiedAccessInstanceLoggingConfiguration
+	ResourceVerifiedAccessInstanceTrustProviderAttachment = newResourceVerifiedAccessInstanceTrustProviderAttachment
+	ResourceVerifiedAccessTrustProvider                   = newResourceVerifiedAccessTrustProvider
 
-	UpdateTags = updateTags
+	ClassifySecurityGroupRules                = classifySecurityGroupRules
+	RemoveSecurityGroupRulesFromIPPermissions = removeSecurityGroupRulesFromIPPermissions
+	SpotFleetRequestChangeRequiresReplacement = spotFleetRequestChangeRequiresReplacement
+	UpdateTags                                = updateTags
+	ValidCapacityRebalanceTerminationDelay    = validCapacityRebalanceTerminationDelay
 )
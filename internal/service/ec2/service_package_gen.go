@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 		{
 			Factory: newDataSourceSecurityGroupRules,
 		},
+		{
+			Factory: newDataSourceSecurityGroupUnmanagedRules,
+			Name:    "Security Group Unmanaged Rules",
+		},
 	}
 }
 
@@ -51,6 +55,53 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory: newResourceVPCBlockPublicAccessExclusion,
+			Name:    "VPC Block Public Access Exclusion",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory: newResourceVPCBlockPublicAccessOptions,
+			Name:    "VPC Block Public Access Options",
+		},
+		{
+			Factory: newResourceVerifiedAccessEndpoint,
+			Name:    "Verified Access Endpoint",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory: newResourceVerifiedAccessGroup,
+			Name:    "Verified Access Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory: newResourceVerifiedAccessInstance,
+			Name:    "Verified Access Instance",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory: newResourceVerifiedAccessInstanceLoggingConfiguration,
+			Name:    "Verified Access Instance Logging Configuration",
+		},
+		{
+			Factory: newResourceVerifiedAccessInstanceTrustProviderAttachment,
+			Name:    "Verified Access Instance Trust Provider Attachment",
+		},
+		{
+			Factory: newResourceVerifiedAccessTrustProvider,
+			Name:    "Verified Access Trust Provider",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 	}
 }
 
@@ -100,6 +151,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceEBSVolumes,
 			TypeName: "aws_ebs_volumes",
 		},
+		{
+			Factory:  DataSourceCapacityBlockOffering,
+			TypeName: "aws_ec2_capacity_block_offering",
+		},
 		{
 			Factory:  DataSourceClientVPNEndpoint,
 			TypeName: "aws_ec2_client_vpn_endpoint",
@@ -132,6 +187,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceInstanceTypes,
 			TypeName: "aws_ec2_instance_types",
 		},
+		{
+			Factory:  DataSourceInstanceTypesFromInstanceRequirements,
+			TypeName: "aws_ec2_instance_types_from_instance_requirements",
+		},
 		{
 			Factory:  DataSourceLocalGateway,
 			TypeName: "aws_ec2_local_gateway",
@@ -477,6 +536,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceEBSEncryptionByDefault,
 			TypeName: "aws_ebs_encryption_by_default",
 		},
+		{
+			Factory:  ResourceEBSFastSnapshotRestore,
+			TypeName: "aws_ebs_fast_snapshot_restore",
+		},
 		{
 			Factory:  ResourceEBSSnapshot,
 			TypeName: "aws_ebs_snapshot",
@@ -485,6 +548,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceEBSSnapshotBlockPublicAccess,
+			TypeName: "aws_ebs_snapshot_block_public_access",
+		},
 		{
 			Factory:  ResourceEBSSnapshotCopy,
 			TypeName: "aws_ebs_snapshot_copy",
@@ -513,6 +580,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAvailabilityZoneGroup,
 			TypeName: "aws_ec2_availability_zone_group",
 		},
+		{
+			Factory:  ResourceCapacityBlockReservation,
+			TypeName: "aws_ec2_capacity_block_reservation",
+			Name:     "Capacity Block Reservation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceCapacityReservation,
 			TypeName: "aws_ec2_capacity_reservation",
@@ -521,6 +596,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceCapacityReservationFleet,
+			TypeName: "aws_ec2_capacity_reservation_fleet",
+			Name:     "Capacity Reservation Fleet",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceCarrierGateway,
 			TypeName: "aws_ec2_carrier_gateway",
@@ -549,6 +632,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceClientVPNRoute,
 			TypeName: "aws_ec2_client_vpn_route",
 		},
+		{
+			Factory:  ResourceDefaultCreditSpecification,
+			TypeName: "aws_ec2_default_credit_specification",
+		},
 		{
 			Factory:  ResourceFleet,
 			TypeName: "aws_ec2_fleet",
@@ -565,6 +652,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceImageBlockPublicAccess,
+			TypeName: "aws_ec2_image_block_public_access",
+		},
+		{
+			Factory:  ResourceInstanceMetadataDefaults,
+			TypeName: "aws_ec2_instance_metadata_defaults",
+		},
 		{
 			Factory:  ResourceInstanceState,
 			TypeName: "aws_ec2_instance_state",
@@ -673,6 +768,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceTransitGatewayDefaultRouteTableAssociation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_association",
+		},
+		{
+			Factory:  ResourceTransitGatewayDefaultRouteTablePropagation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_propagation",
+		},
 		{
 			Factory:  ResourceTransitGatewayMulticastDomain,
 			TypeName: "aws_ec2_transit_gateway_multicast_domain",
@@ -1093,6 +1196,40 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceVPCPeeringConnectionOptions,
 			TypeName: "aws_vpc_peering_connection_options",
 		},
+		{
+			Factory:  ResourceRouteServer,
+			TypeName: "aws_vpc_route_server",
+			Name:     "Route Server",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceRouteServerEndpoint,
+			TypeName: "aws_vpc_route_server_endpoint",
+			Name:     "Route Server Endpoint",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceRouteServerPeer,
+			TypeName: "aws_vpc_route_server_peer",
+			Name:     "Route Server Peer",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceRouteServerPropagation,
+			TypeName: "aws_vpc_route_server_propagation",
+			Name:     "Route Server Propagation",
+		},
+		{
+			Factory:  ResourceRouteServerVPCAssociation,
+			TypeName: "aws_vpc_route_server_vpc_association",
+			Name:     "Route Server VPC Association",
+		},
 		{
 			Factory:  ResourceVPNConnection,
 			TypeName: "aws_vpn_connection",
@@ -304,3 +304,28 @@ func resourceTransitGatewayDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// modifyTransitGatewayDefaultRouteTable modifies a transit gateway's default route table options
+// and waits for the transit gateway to leave the modifying state.
+func modifyTransitGatewayDefaultRouteTable(ctx context.Context, conn *ec2.EC2, input *ec2.ModifyTransitGatewayInput, timeout time.Duration) error {
+	id := aws.StringValue(input.TransitGatewayId)
+
+	// The transit gateway may still be modifying after a previous change.
+	if _, err := WaitTransitGatewayUpdated(ctx, conn, id, timeout); err != nil {
+		return err
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, TransitGatewayIncorrectStateTimeout, func() (interface{}, error) {
+		return conn.ModifyTransitGatewayWithContext(ctx, input)
+	}, errCodeIncorrectState)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := WaitTransitGatewayUpdated(ctx, conn, id, timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
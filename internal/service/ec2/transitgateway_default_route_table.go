@@ -0,0 +1,234 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// transitGatewayDefaultRouteTableKind describes one of a transit gateway's default route tables.
+type transitGatewayDefaultRouteTableKind struct {
+	name             string
+	disabled         func(*ec2.TransitGatewayOptions) bool
+	routeTableID     func(*ec2.TransitGatewayOptions) *string
+	setRouteTableID  func(*ec2.ModifyTransitGatewayOptions, string)
+	resourceTypeName string
+}
+
+var (
+	transitGatewayDefaultRouteTableAssociation = transitGatewayDefaultRouteTableKind{
+		name: "Association",
+		disabled: func(apiObject *ec2.TransitGatewayOptions) bool {
+			return aws.StringValue(apiObject.DefaultRouteTableAssociation) == ec2.DefaultRouteTableAssociationValueDisable
+		},
+		routeTableID: func(apiObject *ec2.TransitGatewayOptions) *string {
+			return apiObject.AssociationDefaultRouteTableId
+		},
+		setRouteTableID: func(apiObject *ec2.ModifyTransitGatewayOptions, v string) {
+			apiObject.AssociationDefaultRouteTableId = aws.String(v)
+		},
+		resourceTypeName: "aws_ec2_transit_gateway_default_route_table_association",
+	}
+	transitGatewayDefaultRouteTablePropagation = transitGatewayDefaultRouteTableKind{
+		name: "Propagation",
+		disabled: func(apiObject *ec2.TransitGatewayOptions) bool {
+			return aws.StringValue(apiObject.DefaultRouteTablePropagation) == ec2.DefaultRouteTablePropagationValueDisable
+		},
+		routeTableID: func(apiObject *ec2.TransitGatewayOptions) *string {
+			return apiObject.PropagationDefaultRouteTableId
+		},
+		setRouteTableID: func(apiObject *ec2.ModifyTransitGatewayOptions, v string) {
+			apiObject.PropagationDefaultRouteTableId = aws.String(v)
+		},
+		resourceTypeName: "aws_ec2_transit_gateway_default_route_table_propagation",
+	}
+)
+
+// @SDKResource("aws_ec2_transit_gateway_default_route_table_association")
+func ResourceTransitGatewayDefaultRouteTableAssociation() *schema.Resource {
+	return resourceTransitGatewayDefaultRouteTable(transitGatewayDefaultRouteTableAssociation)
+}
+
+// @SDKResource("aws_ec2_transit_gateway_default_route_table_propagation")
+func ResourceTransitGatewayDefaultRouteTablePropagation() *schema.Resource {
+	return resourceTransitGatewayDefaultRouteTable(transitGatewayDefaultRouteTablePropagation)
+}
+
+func resourceTransitGatewayDefaultRouteTable(kind transitGatewayDefaultRouteTableKind) *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceTransitGatewayDefaultRouteTableCreate(ctx, d, meta, kind)
+		},
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceTransitGatewayDefaultRouteTableRead(ctx, d, meta, kind)
+		},
+		UpdateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceTransitGatewayDefaultRouteTableUpdate(ctx, d, meta, kind)
+		},
+		DeleteWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceTransitGatewayDefaultRouteTableDelete(ctx, d, meta, kind)
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTransitGatewayDefaultRouteTableImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"original_default_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayDefaultRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, kind transitGatewayDefaultRouteTableKind) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGatewayID := d.Get("transit_gateway_id").(string)
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, transitGatewayID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+	}
+
+	input := &ec2.ModifyTransitGatewayInput{
+		Options:          &ec2.ModifyTransitGatewayOptions{},
+		TransitGatewayId: aws.String(transitGatewayID),
+	}
+	kind.setRouteTableID(input.Options, d.Get("transit_gateway_route_table_id").(string))
+
+	if err := modifyTransitGatewayDefaultRouteTable(ctx, conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway Default Route Table %s (%s): %s", kind.name, transitGatewayID, err)
+	}
+
+	d.SetId(transitGatewayID)
+	d.Set("original_default_route_table_id", kind.routeTableID(transitGateway.Options))
+
+	return append(diags, resourceTransitGatewayDefaultRouteTableRead(ctx, d, meta, kind)...)
+}
+
+func resourceTransitGatewayDefaultRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}, kind transitGatewayDefaultRouteTableKind) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, d.Id())
+
+	if err == nil && kind.disabled(transitGateway.Options) {
+		err = &retry.NotFoundError{}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Default Route Table %s %s not found, removing from state", kind.name, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Default Route Table %s (%s): %s", kind.name, d.Id(), err)
+	}
+
+	d.Set("transit_gateway_id", transitGateway.TransitGatewayId)
+	d.Set("transit_gateway_route_table_id", kind.routeTableID(transitGateway.Options))
+
+	return diags
+}
+
+func resourceTransitGatewayDefaultRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, kind transitGatewayDefaultRouteTableKind) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.ModifyTransitGatewayInput{
+		Options:          &ec2.ModifyTransitGatewayOptions{},
+		TransitGatewayId: aws.String(d.Id()),
+	}
+	kind.setRouteTableID(input.Options, d.Get("transit_gateway_route_table_id").(string))
+
+	if err := modifyTransitGatewayDefaultRouteTable(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Default Route Table %s (%s): %s", kind.name, d.Id(), err)
+	}
+
+	return append(diags, resourceTransitGatewayDefaultRouteTableRead(ctx, d, meta, kind)...)
+}
+
+func resourceTransitGatewayDefaultRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, kind transitGatewayDefaultRouteTableKind) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// Restore the route table that was the default before this resource was created.
+	originalRouteTableID := d.Get("original_default_route_table_id").(string)
+
+	if originalRouteTableID == "" {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Default Route Table %[1]s (%[2]s): original default route table ID is unknown, import with ID %[2]s%[3]sORIGINAL-ROUTE-TABLE-ID to record it or remove %[4]s from state", kind.name, d.Id(), transitGatewayDefaultRouteTableImportIDSeparator, kind.resourceTypeName)
+	}
+
+	input := &ec2.ModifyTransitGatewayInput{
+		Options:          &ec2.ModifyTransitGatewayOptions{},
+		TransitGatewayId: aws.String(d.Id()),
+	}
+	kind.setRouteTableID(input.Options, originalRouteTableID)
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Default Route Table %s: %s", kind.name, d.Id())
+	err := modifyTransitGatewayDefaultRouteTable(ctx, conn, input, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayIDNotFound, errCodeInvalidRouteTableIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Default Route Table %s (%s): %s", kind.name, d.Id(), err)
+	}
+
+	return diags
+}
+
+const transitGatewayDefaultRouteTableImportIDSeparator = ","
+
+// resourceTransitGatewayDefaultRouteTableImport accepts either TRANSIT-GATEWAY-ID or
+// TRANSIT-GATEWAY-ID,ORIGINAL-ROUTE-TABLE-ID. The original default route table can't be
+// discovered from the API and is needed to restore the default on delete.
+func resourceTransitGatewayDefaultRouteTableImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), transitGatewayDefaultRouteTableImportIDSeparator)
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		d.SetId(parts[0])
+		d.Set("original_default_route_table_id", parts[1])
+	default:
+		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected TRANSIT-GATEWAY-ID or TRANSIT-GATEWAY-ID%[2]sORIGINAL-ROUTE-TABLE-ID", d.Id(), transitGatewayDefaultRouteTableImportIDSeparator)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
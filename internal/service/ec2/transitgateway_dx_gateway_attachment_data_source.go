@@ -41,7 +41,7 @@ func DataSourceTransitGatewayDxGatewayAttachment() *schema.Resource {
 func dataSourceTransitGatewayDxGatewayAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	input := &ec2.DescribeTransitGatewayAttachmentsInput{
 		Filters: BuildAttributeFilterList(map[string]string{
@@ -0,0 +1,577 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Verified Access Endpoint")
+// @Tags(identifierAttribute="id")
+func newResourceVerifiedAccessEndpoint(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceVerifiedAccessEndpoint{}
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceVerifiedAccessEndpoint struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceVerifiedAccessEndpoint) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedaccess_endpoint"
+}
+
+func (r *resourceVerifiedAccessEndpoint) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_domain": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"attachment_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.VerifiedAccessEndpointAttachmentType](),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"device_validation_domain": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_certificate_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint_domain": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_domain_prefix": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.VerifiedAccessEndpointType](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_document": schema.StringAttribute{
+				Optional: true,
+			},
+			"security_group_ids": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"verifiedaccess_group_id": schema.StringAttribute{
+				Required: true,
+			},
+			"verifiedaccess_instance_id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"load_balancer_options": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"load_balancer_arn": schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.VerifiedAccessEndpointProtocol](),
+							},
+						},
+						"subnet_ids": schema.SetAttribute{
+							Optional:    true,
+							Computed:    true,
+							ElementType: types.StringType,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(requiresReplaceIfVerifiedAccessEndpointOptionsAddedOrRemoved, "If the block is added or removed, Terraform will destroy and recreate the resource.", "If the block is added or removed, Terraform will destroy and recreate the resource."),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"network_interface_options": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"network_interface_id": schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.VerifiedAccessEndpointProtocol](),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(requiresReplaceIfVerifiedAccessEndpointOptionsAddedOrRemoved, "If the block is added or removed, Terraform will destroy and recreate the resource.", "If the block is added or removed, Terraform will destroy and recreate the resource."),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceVerifiedAccessEndpoint) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceVerifiedAccessEndpointData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.CreateVerifiedAccessEndpointInput{
+		ApplicationDomain:       flex.StringFromFramework(ctx, data.ApplicationDomain),
+		AttachmentType:          awstypes.VerifiedAccessEndpointAttachmentType(data.AttachmentType.ValueString()),
+		ClientToken:             aws.String(id.UniqueId()),
+		Description:             flex.StringFromFramework(ctx, data.Description),
+		DomainCertificateArn:    flex.StringFromFramework(ctx, data.DomainCertificateArn),
+		EndpointDomainPrefix:    flex.StringFromFramework(ctx, data.EndpointDomainPrefix),
+		EndpointType:            awstypes.VerifiedAccessEndpointType(data.EndpointType.ValueString()),
+		LoadBalancerOptions:     flex.ExpandFrameworkListNestedBlockPtr(ctx, data.LoadBalancerOptions, r.expandLoadBalancerOptions),
+		NetworkInterfaceOptions: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.NetworkInterfaceOptions, r.expandNetworkInterfaceOptions),
+		PolicyDocument:          flex.StringFromFramework(ctx, data.PolicyDocument),
+		SecurityGroupIds:        flex.ExpandFrameworkStringValueSet(ctx, data.SecurityGroupIds),
+		TagSpecifications:       getTagSpecificationsInV2(ctx, awstypes.ResourceTypeVerifiedAccessEndpoint),
+		VerifiedAccessGroupId:   flex.StringFromFramework(ctx, data.VerifiedAccessGroupId),
+	}
+
+	output, err := conn.CreateVerifiedAccessEndpoint(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Verified Access Endpoint", err.Error())
+
+		return
+	}
+
+	data.VerifiedAccessEndpointId = flex.StringToFramework(ctx, output.VerifiedAccessEndpoint.VerifiedAccessEndpointId)
+	id := data.VerifiedAccessEndpointId.ValueString()
+
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+	endpoint, err := WaitVerifiedAccessEndpointCreated(ctx, conn, id, createTimeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Verified Access Endpoint (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	r.flatten(ctx, endpoint, &data)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessEndpoint) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceVerifiedAccessEndpointData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.VerifiedAccessEndpointId.ValueString()
+	endpoint, err := FindVerifiedAccessEndpointByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Endpoint (%s)", id), err.Error())
+
+		return
+	}
+
+	r.flatten(ctx, endpoint, &data)
+
+	policy, err := FindVerifiedAccessEndpointPolicyByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Endpoint (%s) policy", id), err.Error())
+
+		return
+	}
+
+	if aws.ToBool(policy.PolicyEnabled) {
+		data.PolicyDocument = flex.StringValueToFramework(ctx, aws.ToString(policy.PolicyDocument))
+	} else {
+		data.PolicyDocument = types.StringNull()
+	}
+
+	setTagsOutV2(ctx, endpoint.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessEndpoint) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceVerifiedAccessEndpointData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := new.VerifiedAccessEndpointId.ValueString()
+
+	if !new.Description.Equal(old.Description) ||
+		!new.LoadBalancerOptions.Equal(old.LoadBalancerOptions) ||
+		!new.NetworkInterfaceOptions.Equal(old.NetworkInterfaceOptions) ||
+		!new.VerifiedAccessGroupId.Equal(old.VerifiedAccessGroupId) {
+		input := &ec2.ModifyVerifiedAccessEndpointInput{
+			VerifiedAccessEndpointId: aws.String(id),
+		}
+
+		if !new.Description.Equal(old.Description) {
+			input.Description = aws.String(new.Description.ValueString())
+		}
+
+		if !new.LoadBalancerOptions.Equal(old.LoadBalancerOptions) {
+			input.LoadBalancerOptions = flex.ExpandFrameworkListNestedBlockPtr(ctx, new.LoadBalancerOptions, r.expandModifyLoadBalancerOptions)
+		}
+
+		if !new.NetworkInterfaceOptions.Equal(old.NetworkInterfaceOptions) {
+			input.NetworkInterfaceOptions = flex.ExpandFrameworkListNestedBlockPtr(ctx, new.NetworkInterfaceOptions, r.expandModifyNetworkInterfaceOptions)
+		}
+
+		if !new.VerifiedAccessGroupId.Equal(old.VerifiedAccessGroupId) {
+			input.VerifiedAccessGroupId = flex.StringFromFramework(ctx, new.VerifiedAccessGroupId)
+		}
+
+		_, err := conn.ModifyVerifiedAccessEndpoint(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Access Endpoint (%s)", id), err.Error())
+
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, new.Timeouts)
+		if _, err := WaitVerifiedAccessEndpointUpdated(ctx, conn, id, updateTimeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Verified Access Endpoint (%s) update", id), err.Error())
+
+			return
+		}
+	}
+
+	if !new.PolicyDocument.Equal(old.PolicyDocument) {
+		input := &ec2.ModifyVerifiedAccessEndpointPolicyInput{
+			PolicyEnabled:            aws.Bool(!new.PolicyDocument.IsNull()),
+			VerifiedAccessEndpointId: aws.String(id),
+		}
+
+		if !new.PolicyDocument.IsNull() {
+			input.PolicyDocument = flex.StringFromFramework(ctx, new.PolicyDocument)
+		}
+
+		_, err := conn.ModifyVerifiedAccessEndpointPolicy(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Access Endpoint (%s) policy", id), err.Error())
+
+			return
+		}
+	}
+
+	endpoint, err := FindVerifiedAccessEndpointByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Endpoint (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	r.flatten(ctx, endpoint, &new)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceVerifiedAccessEndpoint) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceVerifiedAccessEndpointData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	_, err := conn.DeleteVerifiedAccessEndpoint(ctx, &ec2.DeleteVerifiedAccessEndpointInput{
+		ClientToken:              aws.String(id.UniqueId()),
+		VerifiedAccessEndpointId: flex.StringFromFramework(ctx, data.VerifiedAccessEndpointId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessEndpointIdNotFound) {
+		return
+	}
+
+	id := data.VerifiedAccessEndpointId.ValueString()
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Access Endpoint (%s)", id), err.Error())
+
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, data.Timeouts)
+	if _, err := WaitVerifiedAccessEndpointDeleted(ctx, conn, id, deleteTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Verified Access Endpoint (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceVerifiedAccessEndpoint) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func (r *resourceVerifiedAccessEndpoint) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *resourceVerifiedAccessEndpoint) flatten(ctx context.Context, apiObject *awstypes.VerifiedAccessEndpoint, data *resourceVerifiedAccessEndpointData) {
+	data.ApplicationDomain = flex.StringToFramework(ctx, apiObject.ApplicationDomain)
+	data.AttachmentType = flex.StringValueToFramework(ctx, apiObject.AttachmentType)
+	data.Description = flex.StringValueToFramework(ctx, aws.ToString(apiObject.Description))
+	data.DeviceValidationDomain = flex.StringToFramework(ctx, apiObject.DeviceValidationDomain)
+	data.DomainCertificateArn = flex.StringToFramework(ctx, apiObject.DomainCertificateArn)
+	data.EndpointDomain = flex.StringToFramework(ctx, apiObject.EndpointDomain)
+	data.EndpointType = flex.StringValueToFramework(ctx, apiObject.EndpointType)
+	data.LoadBalancerOptions = r.flattenLoadBalancerOptions(ctx, apiObject.LoadBalancerOptions)
+	data.NetworkInterfaceOptions = r.flattenNetworkInterfaceOptions(ctx, apiObject.NetworkInterfaceOptions)
+	data.SecurityGroupIds = flex.FlattenFrameworkStringValueSet(ctx, apiObject.SecurityGroupIds)
+	data.VerifiedAccessGroupId = flex.StringToFramework(ctx, apiObject.VerifiedAccessGroupId)
+	data.VerifiedAccessInstanceId = flex.StringToFramework(ctx, apiObject.VerifiedAccessInstanceId)
+}
+
+func (r *resourceVerifiedAccessEndpoint) expandLoadBalancerOptions(ctx context.Context, data verifiedAccessEndpointLoadBalancerOptionsData) *awstypes.CreateVerifiedAccessEndpointLoadBalancerOptions {
+	return &awstypes.CreateVerifiedAccessEndpointLoadBalancerOptions{
+		LoadBalancerArn: flex.StringFromFramework(ctx, data.LoadBalancerArn),
+		Port:            flex.Int32FromFramework(ctx, data.Port),
+		Protocol:        awstypes.VerifiedAccessEndpointProtocol(data.Protocol.ValueString()),
+		SubnetIds:       flex.ExpandFrameworkStringValueSet(ctx, data.SubnetIds),
+	}
+}
+
+func (r *resourceVerifiedAccessEndpoint) expandModifyLoadBalancerOptions(ctx context.Context, data verifiedAccessEndpointLoadBalancerOptionsData) *awstypes.ModifyVerifiedAccessEndpointLoadBalancerOptions {
+	return &awstypes.ModifyVerifiedAccessEndpointLoadBalancerOptions{
+		Port:      flex.Int32FromFramework(ctx, data.Port),
+		Protocol:  awstypes.VerifiedAccessEndpointProtocol(data.Protocol.ValueString()),
+		SubnetIds: flex.ExpandFrameworkStringValueSet(ctx, data.SubnetIds),
+	}
+}
+
+func (r *resourceVerifiedAccessEndpoint) flattenLoadBalancerOptions(ctx context.Context, apiObject *awstypes.VerifiedAccessEndpointLoadBalancerOptions) types.List {
+	attributeTypes := flex.AttributeTypesMust[verifiedAccessEndpointLoadBalancerOptionsData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"load_balancer_arn": flex.StringToFramework(ctx, apiObject.LoadBalancerArn),
+			"port":              flex.Int32ToFramework(ctx, apiObject.Port),
+			"protocol":          flex.StringValueToFramework(ctx, apiObject.Protocol),
+			"subnet_ids":        flex.FlattenFrameworkStringValueSet(ctx, apiObject.SubnetIds),
+		}),
+	})
+}
+
+func (r *resourceVerifiedAccessEndpoint) expandNetworkInterfaceOptions(ctx context.Context, data verifiedAccessEndpointNetworkInterfaceOptionsData) *awstypes.CreateVerifiedAccessEndpointEniOptions {
+	return &awstypes.CreateVerifiedAccessEndpointEniOptions{
+		NetworkInterfaceId: flex.StringFromFramework(ctx, data.NetworkInterfaceId),
+		Port:               flex.Int32FromFramework(ctx, data.Port),
+		Protocol:           awstypes.VerifiedAccessEndpointProtocol(data.Protocol.ValueString()),
+	}
+}
+
+func (r *resourceVerifiedAccessEndpoint) expandModifyNetworkInterfaceOptions(ctx context.Context, data verifiedAccessEndpointNetworkInterfaceOptionsData) *awstypes.ModifyVerifiedAccessEndpointEniOptions {
+	return &awstypes.ModifyVerifiedAccessEndpointEniOptions{
+		Port:     flex.Int32FromFramework(ctx, data.Port),
+		Protocol: awstypes.VerifiedAccessEndpointProtocol(data.Protocol.ValueString()),
+	}
+}
+
+func (r *resourceVerifiedAccessEndpoint) flattenNetworkInterfaceOptions(ctx context.Context, apiObject *awstypes.VerifiedAccessEndpointEniOptions) types.List {
+	attributeTypes := flex.AttributeTypesMust[verifiedAccessEndpointNetworkInterfaceOptionsData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"network_interface_id": flex.StringToFramework(ctx, apiObject.NetworkInterfaceId),
+			"port":                 flex.Int32ToFramework(ctx, apiObject.Port),
+			"protocol":             flex.StringValueToFramework(ctx, apiObject.Protocol),
+		}),
+	})
+}
+
+// requiresReplaceIfVerifiedAccessEndpointOptionsAddedOrRemoved forces replacement when an
+// endpoint options block is added or removed, as the endpoint type cannot be changed in-place.
+func requiresReplaceIfVerifiedAccessEndpointOptionsAddedOrRemoved(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	response.RequiresReplace = len(request.StateValue.Elements()) != len(request.PlanValue.Elements())
+}
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_VerifiedAccessEndpoint.html.
+type resourceVerifiedAccessEndpointData struct {
+	ApplicationDomain        types.String   `tfsdk:"application_domain"`
+	AttachmentType           types.String   `tfsdk:"attachment_type"`
+	Description              types.String   `tfsdk:"description"`
+	DeviceValidationDomain   types.String   `tfsdk:"device_validation_domain"`
+	DomainCertificateArn     types.String   `tfsdk:"domain_certificate_arn"`
+	EndpointDomain           types.String   `tfsdk:"endpoint_domain"`
+	EndpointDomainPrefix     types.String   `tfsdk:"endpoint_domain_prefix"`
+	EndpointType             types.String   `tfsdk:"endpoint_type"`
+	VerifiedAccessEndpointId types.String   `tfsdk:"id"`
+	LoadBalancerOptions      types.List     `tfsdk:"load_balancer_options"`
+	NetworkInterfaceOptions  types.List     `tfsdk:"network_interface_options"`
+	PolicyDocument           types.String   `tfsdk:"policy_document"`
+	SecurityGroupIds         types.Set      `tfsdk:"security_group_ids"`
+	Tags                     types.Map      `tfsdk:"tags"`
+	TagsAll                  types.Map      `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	VerifiedAccessGroupId    types.String   `tfsdk:"verifiedaccess_group_id"`
+	VerifiedAccessInstanceId types.String   `tfsdk:"verifiedaccess_instance_id"`
+}
+
+type verifiedAccessEndpointLoadBalancerOptionsData struct {
+	LoadBalancerArn types.String `tfsdk:"load_balancer_arn"`
+	Port            types.Int64  `tfsdk:"port"`
+	Protocol        types.String `tfsdk:"protocol"`
+	SubnetIds       types.Set    `tfsdk:"subnet_ids"`
+}
+
+type verifiedAccessEndpointNetworkInterfaceOptionsData struct {
+	NetworkInterfaceId types.String `tfsdk:"network_interface_id"`
+	Port               types.Int64  `tfsdk:"port"`
+	Protocol           types.String `tfsdk:"protocol"`
+}
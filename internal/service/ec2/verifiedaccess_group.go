@@ -0,0 +1,287 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Verified Access Group")
+// @Tags(identifierAttribute="id")
+func newResourceVerifiedAccessGroup(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceVerifiedAccessGroup{}, nil
+}
+
+type resourceVerifiedAccessGroup struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceVerifiedAccessGroup) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedaccess_group"
+}
+
+func (r *resourceVerifiedAccessGroup) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_updated_time": schema.StringAttribute{
+				Computed: true,
+			},
+			"owner": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_document": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"verifiedaccess_instance_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *resourceVerifiedAccessGroup) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceVerifiedAccessGroupData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.CreateVerifiedAccessGroupInput{
+		ClientToken:              aws.String(id.UniqueId()),
+		Description:              flex.StringFromFramework(ctx, data.Description),
+		PolicyDocument:           flex.StringFromFramework(ctx, data.PolicyDocument),
+		TagSpecifications:        getTagSpecificationsInV2(ctx, awstypes.ResourceTypeVerifiedAccessGroup),
+		VerifiedAccessInstanceId: flex.StringFromFramework(ctx, data.VerifiedAccessInstanceId),
+	}
+
+	output, err := conn.CreateVerifiedAccessGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Verified Access Group", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	if err := flex.Flatten(ctx, output.VerifiedAccessGroup, &data); err != nil {
+		response.Diagnostics.AddError("flattening data", err.Error())
+
+		return
+	}
+	data.Description = flex.StringValueToFramework(ctx, aws.ToString(output.VerifiedAccessGroup.Description))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessGroup) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceVerifiedAccessGroupData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.VerifiedAccessGroupId.ValueString()
+	group, err := FindVerifiedAccessGroupByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Group (%s)", id), err.Error())
+
+		return
+	}
+
+	if err := flex.Flatten(ctx, group, &data); err != nil {
+		response.Diagnostics.AddError("flattening data", err.Error())
+
+		return
+	}
+	data.Description = flex.StringValueToFramework(ctx, aws.ToString(group.Description))
+
+	policy, err := FindVerifiedAccessGroupPolicyByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Group (%s) policy", id), err.Error())
+
+		return
+	}
+
+	if aws.ToBool(policy.PolicyEnabled) {
+		data.PolicyDocument = flex.StringValueToFramework(ctx, aws.ToString(policy.PolicyDocument))
+	} else {
+		data.PolicyDocument = types.StringNull()
+	}
+
+	setTagsOutV2(ctx, group.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessGroup) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceVerifiedAccessGroupData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := new.VerifiedAccessGroupId.ValueString()
+
+	if !new.Description.Equal(old.Description) || !new.VerifiedAccessInstanceId.Equal(old.VerifiedAccessInstanceId) {
+		input := &ec2.ModifyVerifiedAccessGroupInput{
+			VerifiedAccessGroupId: aws.String(id),
+		}
+
+		if !new.Description.Equal(old.Description) {
+			input.Description = aws.String(new.Description.ValueString())
+		}
+
+		if !new.VerifiedAccessInstanceId.Equal(old.VerifiedAccessInstanceId) {
+			input.VerifiedAccessInstanceId = flex.StringFromFramework(ctx, new.VerifiedAccessInstanceId)
+		}
+
+		_, err := conn.ModifyVerifiedAccessGroup(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Access Group (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	if !new.PolicyDocument.Equal(old.PolicyDocument) {
+		input := &ec2.ModifyVerifiedAccessGroupPolicyInput{
+			PolicyEnabled:         aws.Bool(!new.PolicyDocument.IsNull()),
+			VerifiedAccessGroupId: aws.String(id),
+		}
+
+		if !new.PolicyDocument.IsNull() {
+			input.PolicyDocument = flex.StringFromFramework(ctx, new.PolicyDocument)
+		}
+
+		_, err := conn.ModifyVerifiedAccessGroupPolicy(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Access Group (%s) policy", id), err.Error())
+
+			return
+		}
+	}
+
+	group, err := FindVerifiedAccessGroupByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Group (%s)", id), err.Error())
+
+		return
+	}
+
+	new.LastUpdatedTime = flex.StringToFramework(ctx, group.LastUpdatedTime)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceVerifiedAccessGroup) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceVerifiedAccessGroupData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	_, err := conn.DeleteVerifiedAccessGroup(ctx, &ec2.DeleteVerifiedAccessGroupInput{
+		ClientToken:           aws.String(id.UniqueId()),
+		VerifiedAccessGroupId: flex.StringFromFramework(ctx, data.VerifiedAccessGroupId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessGroupIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Access Group (%s)", data.VerifiedAccessGroupId.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceVerifiedAccessGroup) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_VerifiedAccessGroup.html.
+type resourceVerifiedAccessGroupData struct {
+	VerifiedAccessGroupArn   types.String `tfsdk:"arn"`
+	CreationTime             types.String `tfsdk:"creation_time"`
+	Description              types.String `tfsdk:"description"`
+	VerifiedAccessGroupId    types.String `tfsdk:"id"`
+	LastUpdatedTime          types.String `tfsdk:"last_updated_time"`
+	Owner                    types.String `tfsdk:"owner"`
+	PolicyDocument           types.String `tfsdk:"policy_document"`
+	Tags                     types.Map    `tfsdk:"tags"`
+	TagsAll                  types.Map    `tfsdk:"tags_all"`
+	VerifiedAccessInstanceId types.String `tfsdk:"verifiedaccess_instance_id"`
+}
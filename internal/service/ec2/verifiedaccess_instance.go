@@ -0,0 +1,222 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Verified Access Instance")
+// @Tags(identifierAttribute="id")
+func newResourceVerifiedAccessInstance(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceVerifiedAccessInstance{}, nil
+}
+
+type resourceVerifiedAccessInstance struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceVerifiedAccessInstance) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedaccess_instance"
+}
+
+func (r *resourceVerifiedAccessInstance) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"creation_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_updated_time": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *resourceVerifiedAccessInstance) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceVerifiedAccessInstanceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.CreateVerifiedAccessInstanceInput{
+		ClientToken:       aws.String(id.UniqueId()),
+		Description:       flex.StringFromFramework(ctx, data.Description),
+		TagSpecifications: getTagSpecificationsInV2(ctx, awstypes.ResourceTypeVerifiedAccessInstance),
+	}
+
+	output, err := conn.CreateVerifiedAccessInstance(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Verified Access Instance", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	if err := flex.Flatten(ctx, output.VerifiedAccessInstance, &data); err != nil {
+		response.Diagnostics.AddError("flattening data", err.Error())
+
+		return
+	}
+	data.Description = flex.StringValueToFramework(ctx, aws.ToString(output.VerifiedAccessInstance.Description))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessInstance) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceVerifiedAccessInstanceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.VerifiedAccessInstanceId.ValueString()
+	instance, err := FindVerifiedAccessInstanceByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Instance (%s)", id), err.Error())
+
+		return
+	}
+
+	if err := flex.Flatten(ctx, instance, &data); err != nil {
+		response.Diagnostics.AddError("flattening data", err.Error())
+
+		return
+	}
+	data.Description = flex.StringValueToFramework(ctx, aws.ToString(instance.Description))
+
+	setTagsOutV2(ctx, instance.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessInstance) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceVerifiedAccessInstanceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := new.VerifiedAccessInstanceId.ValueString()
+
+	if !new.Description.Equal(old.Description) {
+		input := &ec2.ModifyVerifiedAccessInstanceInput{
+			Description:              aws.String(new.Description.ValueString()),
+			VerifiedAccessInstanceId: aws.String(id),
+		}
+
+		_, err := conn.ModifyVerifiedAccessInstance(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Access Instance (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	instance, err := FindVerifiedAccessInstanceByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Instance (%s)", id), err.Error())
+
+		return
+	}
+
+	new.LastUpdatedTime = flex.StringToFramework(ctx, instance.LastUpdatedTime)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceVerifiedAccessInstance) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceVerifiedAccessInstanceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	_, err := conn.DeleteVerifiedAccessInstance(ctx, &ec2.DeleteVerifiedAccessInstanceInput{
+		VerifiedAccessInstanceId: flex.StringFromFramework(ctx, data.VerifiedAccessInstanceId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Access Instance (%s)", data.VerifiedAccessInstanceId.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceVerifiedAccessInstance) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_VerifiedAccessInstance.html.
+type resourceVerifiedAccessInstanceData struct {
+	CreationTime             types.String `tfsdk:"creation_time"`
+	Description              types.String `tfsdk:"description"`
+	VerifiedAccessInstanceId types.String `tfsdk:"id"`
+	LastUpdatedTime          types.String `tfsdk:"last_updated_time"`
+	Tags                     types.Map    `tfsdk:"tags"`
+	TagsAll                  types.Map    `tfsdk:"tags_all"`
+}
@@ -0,0 +1,427 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Verified Access Instance Logging Configuration")
+func newResourceVerifiedAccessInstanceLoggingConfiguration(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceVerifiedAccessInstanceLoggingConfiguration{}, nil
+}
+
+type resourceVerifiedAccessInstanceLoggingConfiguration struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedaccess_instance_logging_configuration"
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"verifiedaccess_instance_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"access_logs": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"include_trust_context": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"log_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"cloudwatch_logs": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Required: true,
+									},
+									"log_group": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"kinesis_data_firehose": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"delivery_stream": schema.StringAttribute{
+										Optional: true,
+									},
+									"enabled": schema.BoolAttribute{
+										Required: true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"s3": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bucket_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"bucket_owner": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"enabled": schema.BoolAttribute{
+										Required: true,
+									},
+									"prefix": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceVerifiedAccessInstanceLoggingConfigurationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.VerifiedAccessInstanceId.ValueString()
+	output, err := r.modify(ctx, conn, id, flex.ExpandFrameworkListNestedBlockPtr(ctx, data.AccessLogs, r.expandAccessLogs))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Access Instance (%s) Logging Configuration", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+	data.AccessLogs = r.flattenAccessLogs(ctx, output.LoggingConfiguration.AccessLogs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceVerifiedAccessInstanceLoggingConfigurationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.ID.ValueString()
+	loggingConfiguration, err := FindVerifiedAccessInstanceLoggingConfigurationByInstanceID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Instance (%s) Logging Configuration", id), err.Error())
+
+		return
+	}
+
+	data.AccessLogs = r.flattenAccessLogs(ctx, loggingConfiguration.AccessLogs)
+	data.VerifiedAccessInstanceId = flex.StringToFramework(ctx, loggingConfiguration.VerifiedAccessInstanceId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data resourceVerifiedAccessInstanceLoggingConfigurationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.ID.ValueString()
+	output, err := r.modify(ctx, conn, id, flex.ExpandFrameworkListNestedBlockPtr(ctx, data.AccessLogs, r.expandAccessLogs))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Verified Access Instance (%s) Logging Configuration", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AccessLogs = r.flattenAccessLogs(ctx, output.LoggingConfiguration.AccessLogs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceVerifiedAccessInstanceLoggingConfigurationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// Disable all log destinations.
+	id := data.ID.ValueString()
+	_, err := r.modify(ctx, conn, id, &awstypes.VerifiedAccessLogOptions{
+		CloudWatchLogs: &awstypes.VerifiedAccessLogCloudWatchLogsDestinationOptions{
+			Enabled: aws.Bool(false),
+		},
+		IncludeTrustContext: aws.Bool(false),
+		KinesisDataFirehose: &awstypes.VerifiedAccessLogKinesisDataFirehoseDestinationOptions{
+			Enabled: aws.Bool(false),
+		},
+		S3: &awstypes.VerifiedAccessLogS3DestinationOptions{
+			Enabled: aws.Bool(false),
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Access Instance (%s) Logging Configuration", id), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) modify(ctx context.Context, conn *ec2.Client, instanceID string, accessLogs *awstypes.VerifiedAccessLogOptions) (*ec2.ModifyVerifiedAccessInstanceLoggingConfigurationOutput, error) {
+	input := &ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput{
+		AccessLogs:               accessLogs,
+		ClientToken:              aws.String(id.UniqueId()),
+		VerifiedAccessInstanceId: aws.String(instanceID),
+	}
+
+	return conn.ModifyVerifiedAccessInstanceLoggingConfiguration(ctx, input)
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) expandAccessLogs(ctx context.Context, data verifiedAccessLogsData) *awstypes.VerifiedAccessLogOptions {
+	apiObject := &awstypes.VerifiedAccessLogOptions{
+		IncludeTrustContext: flex.BoolFromFramework(ctx, data.IncludeTrustContext),
+		LogVersion:          flex.StringFromFramework(ctx, data.LogVersion),
+		// Destinations that are not configured are disabled.
+		CloudWatchLogs: &awstypes.VerifiedAccessLogCloudWatchLogsDestinationOptions{
+			Enabled: aws.Bool(false),
+		},
+		KinesisDataFirehose: &awstypes.VerifiedAccessLogKinesisDataFirehoseDestinationOptions{
+			Enabled: aws.Bool(false),
+		},
+		S3: &awstypes.VerifiedAccessLogS3DestinationOptions{
+			Enabled: aws.Bool(false),
+		},
+	}
+
+	if v := flex.ExpandFrameworkListNestedBlockPtr(ctx, data.CloudWatchLogs, r.expandCloudWatchLogs); v != nil {
+		apiObject.CloudWatchLogs = v
+	}
+
+	if v := flex.ExpandFrameworkListNestedBlockPtr(ctx, data.KinesisDataFirehose, r.expandKinesisDataFirehose); v != nil {
+		apiObject.KinesisDataFirehose = v
+	}
+
+	if v := flex.ExpandFrameworkListNestedBlockPtr(ctx, data.S3, r.expandS3); v != nil {
+		apiObject.S3 = v
+	}
+
+	return apiObject
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) expandCloudWatchLogs(ctx context.Context, data verifiedAccessLogCloudWatchLogsData) *awstypes.VerifiedAccessLogCloudWatchLogsDestinationOptions {
+	return &awstypes.VerifiedAccessLogCloudWatchLogsDestinationOptions{
+		Enabled:  flex.BoolFromFramework(ctx, data.Enabled),
+		LogGroup: flex.StringFromFramework(ctx, data.LogGroup),
+	}
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) expandKinesisDataFirehose(ctx context.Context, data verifiedAccessLogKinesisDataFirehoseData) *awstypes.VerifiedAccessLogKinesisDataFirehoseDestinationOptions {
+	return &awstypes.VerifiedAccessLogKinesisDataFirehoseDestinationOptions{
+		DeliveryStream: flex.StringFromFramework(ctx, data.DeliveryStream),
+		Enabled:        flex.BoolFromFramework(ctx, data.Enabled),
+	}
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) expandS3(ctx context.Context, data verifiedAccessLogS3Data) *awstypes.VerifiedAccessLogS3DestinationOptions {
+	return &awstypes.VerifiedAccessLogS3DestinationOptions{
+		BucketName:  flex.StringFromFramework(ctx, data.BucketName),
+		BucketOwner: flex.StringFromFramework(ctx, data.BucketOwner),
+		Enabled:     flex.BoolFromFramework(ctx, data.Enabled),
+		Prefix:      flex.StringFromFramework(ctx, data.Prefix),
+	}
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) flattenAccessLogs(ctx context.Context, apiObject *awstypes.VerifiedAccessLogs) types.List {
+	attributeTypes := flex.AttributeTypesMust[verifiedAccessLogsData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"cloudwatch_logs":       r.flattenCloudWatchLogs(ctx, apiObject.CloudWatchLogs),
+			"include_trust_context": flex.BoolToFramework(ctx, apiObject.IncludeTrustContext),
+			"kinesis_data_firehose": r.flattenKinesisDataFirehose(ctx, apiObject.KinesisDataFirehose),
+			"log_version":           flex.StringToFramework(ctx, apiObject.LogVersion),
+			"s3":                    r.flattenS3(ctx, apiObject.S3),
+		}),
+	})
+}
+
+// Disabled destinations are represented by the absence of the corresponding block.
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) flattenCloudWatchLogs(ctx context.Context, apiObject *awstypes.VerifiedAccessLogCloudWatchLogsDestination) types.List {
+	attributeTypes := flex.AttributeTypesMust[verifiedAccessLogCloudWatchLogsData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil || !aws.ToBool(apiObject.Enabled) {
+		return types.ListValueMust(elementType, []attr.Value{})
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"enabled":   flex.BoolToFramework(ctx, apiObject.Enabled),
+			"log_group": flex.StringToFramework(ctx, apiObject.LogGroup),
+		}),
+	})
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) flattenKinesisDataFirehose(ctx context.Context, apiObject *awstypes.VerifiedAccessLogKinesisDataFirehoseDestination) types.List {
+	attributeTypes := flex.AttributeTypesMust[verifiedAccessLogKinesisDataFirehoseData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil || !aws.ToBool(apiObject.Enabled) {
+		return types.ListValueMust(elementType, []attr.Value{})
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"delivery_stream": flex.StringToFramework(ctx, apiObject.DeliveryStream),
+			"enabled":         flex.BoolToFramework(ctx, apiObject.Enabled),
+		}),
+	})
+}
+
+func (r *resourceVerifiedAccessInstanceLoggingConfiguration) flattenS3(ctx context.Context, apiObject *awstypes.VerifiedAccessLogS3Destination) types.List {
+	attributeTypes := flex.AttributeTypesMust[verifiedAccessLogS3Data](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil || !aws.ToBool(apiObject.Enabled) {
+		return types.ListValueMust(elementType, []attr.Value{})
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"bucket_name":  flex.StringToFramework(ctx, apiObject.BucketName),
+			"bucket_owner": flex.StringToFramework(ctx, apiObject.BucketOwner),
+			"enabled":      flex.BoolToFramework(ctx, apiObject.Enabled),
+			"prefix":       flex.StringToFramework(ctx, apiObject.Prefix),
+		}),
+	})
+}
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_VerifiedAccessInstanceLoggingConfiguration.html.
+type resourceVerifiedAccessInstanceLoggingConfigurationData struct {
+	AccessLogs               types.List   `tfsdk:"access_logs"`
+	ID                       types.String `tfsdk:"id"`
+	VerifiedAccessInstanceId types.String `tfsdk:"verifiedaccess_instance_id"`
+}
+
+type verifiedAccessLogsData struct {
+	CloudWatchLogs      types.List   `tfsdk:"cloudwatch_logs"`
+	IncludeTrustContext types.Bool   `tfsdk:"include_trust_context"`
+	KinesisDataFirehose types.List   `tfsdk:"kinesis_data_firehose"`
+	LogVersion          types.String `tfsdk:"log_version"`
+	S3                  types.List   `tfsdk:"s3"`
+}
+
+type verifiedAccessLogCloudWatchLogsData struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	LogGroup types.String `tfsdk:"log_group"`
+}
+
+type verifiedAccessLogKinesisDataFirehoseData struct {
+	DeliveryStream types.String `tfsdk:"delivery_stream"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+}
+
+type verifiedAccessLogS3Data struct {
+	BucketName  types.String `tfsdk:"bucket_name"`
+	BucketOwner types.String `tfsdk:"bucket_owner"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Prefix      types.String `tfsdk:"prefix"`
+}
@@ -0,0 +1,184 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Verified Access Instance Trust Provider Attachment")
+func newResourceVerifiedAccessInstanceTrustProviderAttachment(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceVerifiedAccessInstanceTrustProviderAttachment{}, nil
+}
+
+type resourceVerifiedAccessInstanceTrustProviderAttachment struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceVerifiedAccessInstanceTrustProviderAttachment) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedaccess_instance_trust_provider_attachment"
+}
+
+func (r *resourceVerifiedAccessInstanceTrustProviderAttachment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"verifiedaccess_instance_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"verifiedaccess_trust_provider_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceVerifiedAccessInstanceTrustProviderAttachment) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceVerifiedAccessInstanceTrustProviderAttachmentData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	instanceID, trustProviderID := data.VerifiedAccessInstanceId.ValueString(), data.VerifiedAccessTrustProviderId.ValueString()
+	input := &ec2.AttachVerifiedAccessTrustProviderInput{
+		ClientToken:                   aws.String(id.UniqueId()),
+		VerifiedAccessInstanceId:      aws.String(instanceID),
+		VerifiedAccessTrustProviderId: aws.String(trustProviderID),
+	}
+
+	_, err := conn.AttachVerifiedAccessTrustProvider(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("attaching Verified Access Trust Provider (%s) to Instance (%s)", trustProviderID, instanceID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(VerifiedAccessInstanceTrustProviderAttachmentCreateResourceID(instanceID, trustProviderID))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessInstanceTrustProviderAttachment) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceVerifiedAccessInstanceTrustProviderAttachmentData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID, trustProviderID, err := VerifiedAccessInstanceTrustProviderAttachmentParseResourceID(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	err = FindVerifiedAccessInstanceTrustProviderAttachmentExists(ctx, conn, instanceID, trustProviderID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Instance Trust Provider Attachment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.VerifiedAccessInstanceId = types.StringValue(instanceID)
+	data.VerifiedAccessTrustProviderId = types.StringValue(trustProviderID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessInstanceTrustProviderAttachment) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *resourceVerifiedAccessInstanceTrustProviderAttachment) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceVerifiedAccessInstanceTrustProviderAttachmentData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	_, err := conn.DetachVerifiedAccessTrustProvider(ctx, &ec2.DetachVerifiedAccessTrustProviderInput{
+		ClientToken:                   aws.String(id.UniqueId()),
+		VerifiedAccessInstanceId:      aws.String(data.VerifiedAccessInstanceId.ValueString()),
+		VerifiedAccessTrustProviderId: aws.String(data.VerifiedAccessTrustProviderId.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound, errCodeInvalidVerifiedAccessTrustProviderIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Access Instance Trust Provider Attachment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+const verifiedAccessInstanceTrustProviderAttachmentResourceIDSeparator = "/"
+
+func VerifiedAccessInstanceTrustProviderAttachmentCreateResourceID(instanceID, trustProviderID string) string {
+	parts := []string{instanceID, trustProviderID}
+	id := strings.Join(parts, verifiedAccessInstanceTrustProviderAttachmentResourceIDSeparator)
+
+	return id
+}
+
+func VerifiedAccessInstanceTrustProviderAttachmentParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, verifiedAccessInstanceTrustProviderAttachmentResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected VERIFIED-ACCESS-INSTANCE-ID%[2]sVERIFIED-ACCESS-TRUST-PROVIDER-ID", id, verifiedAccessInstanceTrustProviderAttachmentResourceIDSeparator)
+}
+
+type resourceVerifiedAccessInstanceTrustProviderAttachmentData struct {
+	ID                            types.String `tfsdk:"id"`
+	VerifiedAccessInstanceId      types.String `tfsdk:"verifiedaccess_instance_id"`
+	VerifiedAccessTrustProviderId types.String `tfsdk:"verifiedaccess_trust_provider_id"`
+}
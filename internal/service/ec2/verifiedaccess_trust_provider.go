@@ -0,0 +1,396 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Verified Access Trust Provider")
+// @Tags(identifierAttribute="id")
+func newResourceVerifiedAccessTrustProvider(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceVerifiedAccessTrustProvider{}, nil
+}
+
+type resourceVerifiedAccessTrustProvider struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceVerifiedAccessTrustProvider) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedaccess_trust_provider"
+}
+
+func (r *resourceVerifiedAccessTrustProvider) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"device_trust_provider_type": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.DeviceTrustProviderType](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_reference_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"trust_provider_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.TrustProviderType](),
+				},
+			},
+			"user_trust_provider_type": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.UserTrustProviderType](),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"device_options": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tenant_id": schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"oidc_options": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"authorization_endpoint": schema.StringAttribute{
+							Optional: true,
+						},
+						"client_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"client_secret": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+						"issuer": schema.StringAttribute{
+							Optional: true,
+						},
+						"scope": schema.StringAttribute{
+							Optional: true,
+						},
+						"token_endpoint": schema.StringAttribute{
+							Optional: true,
+						},
+						"user_info_endpoint": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceVerifiedAccessTrustProvider) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceVerifiedAccessTrustProviderData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.CreateVerifiedAccessTrustProviderInput{
+		ClientToken:             aws.String(id.UniqueId()),
+		Description:             flex.StringFromFramework(ctx, data.Description),
+		DeviceOptions:           flex.ExpandFrameworkListNestedBlockPtr(ctx, data.DeviceOptions, r.expandDeviceOptions),
+		DeviceTrustProviderType: awstypes.DeviceTrustProviderType(data.DeviceTrustProviderType.ValueString()),
+		OidcOptions:             flex.ExpandFrameworkListNestedBlockPtr(ctx, data.OidcOptions, r.expandOIDCOptions),
+		PolicyReferenceName:     flex.StringFromFramework(ctx, data.PolicyReferenceName),
+		TagSpecifications:       getTagSpecificationsInV2(ctx, awstypes.ResourceTypeVerifiedAccessTrustProvider),
+		TrustProviderType:       awstypes.TrustProviderType(data.TrustProviderType.ValueString()),
+		UserTrustProviderType:   awstypes.UserTrustProviderType(data.UserTrustProviderType.ValueString()),
+	}
+
+	output, err := conn.CreateVerifiedAccessTrustProvider(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Verified Access Trust Provider", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.VerifiedAccessTrustProviderId = flex.StringToFramework(ctx, output.VerifiedAccessTrustProvider.VerifiedAccessTrustProviderId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessTrustProvider) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceVerifiedAccessTrustProviderData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.VerifiedAccessTrustProviderId.ValueString()
+	trustProvider, err := FindVerifiedAccessTrustProviderByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Access Trust Provider (%s)", id), err.Error())
+
+		return
+	}
+
+	data.Description = flex.StringValueToFramework(ctx, aws.ToString(trustProvider.Description))
+	data.DeviceOptions = r.flattenDeviceOptions(ctx, trustProvider.DeviceOptions)
+	data.DeviceTrustProviderType = flex.StringValueToFramework(ctx, trustProvider.DeviceTrustProviderType)
+	data.OidcOptions = r.flattenOIDCOptions(ctx, trustProvider.OidcOptions, data.OidcOptions)
+	data.PolicyReferenceName = flex.StringToFramework(ctx, trustProvider.PolicyReferenceName)
+	data.TrustProviderType = flex.StringValueToFramework(ctx, trustProvider.TrustProviderType)
+	data.UserTrustProviderType = flex.StringValueToFramework(ctx, trustProvider.UserTrustProviderType)
+
+	setTagsOutV2(ctx, trustProvider.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVerifiedAccessTrustProvider) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceVerifiedAccessTrustProviderData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.OidcOptions.Equal(old.OidcOptions) {
+		input := &ec2.ModifyVerifiedAccessTrustProviderInput{
+			ClientToken:                   aws.String(id.UniqueId()),
+			VerifiedAccessTrustProviderId: flex.StringFromFramework(ctx, new.VerifiedAccessTrustProviderId),
+		}
+
+		if !new.Description.Equal(old.Description) {
+			input.Description = aws.String(new.Description.ValueString())
+		}
+
+		if !new.OidcOptions.Equal(old.OidcOptions) {
+			input.OidcOptions = flex.ExpandFrameworkListNestedBlockPtr(ctx, new.OidcOptions, r.expandModifyOIDCOptions)
+		}
+
+		_, err := conn.ModifyVerifiedAccessTrustProvider(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Access Trust Provider (%s)", new.VerifiedAccessTrustProviderId.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceVerifiedAccessTrustProvider) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceVerifiedAccessTrustProviderData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	_, err := conn.DeleteVerifiedAccessTrustProvider(ctx, &ec2.DeleteVerifiedAccessTrustProviderInput{
+		ClientToken:                   aws.String(id.UniqueId()),
+		VerifiedAccessTrustProviderId: flex.StringFromFramework(ctx, data.VerifiedAccessTrustProviderId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessTrustProviderIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Access Trust Provider (%s)", data.VerifiedAccessTrustProviderId.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceVerifiedAccessTrustProvider) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *resourceVerifiedAccessTrustProvider) expandDeviceOptions(ctx context.Context, data verifiedAccessDeviceOptionsData) *awstypes.CreateVerifiedAccessTrustProviderDeviceOptions {
+	return &awstypes.CreateVerifiedAccessTrustProviderDeviceOptions{
+		TenantId: flex.StringFromFramework(ctx, data.TenantId),
+	}
+}
+
+func (r *resourceVerifiedAccessTrustProvider) flattenDeviceOptions(ctx context.Context, apiObject *awstypes.DeviceOptions) types.List {
+	attributeTypes := flex.AttributeTypesMust[verifiedAccessDeviceOptionsData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"tenant_id": flex.StringToFramework(ctx, apiObject.TenantId),
+		}),
+	})
+}
+
+func (r *resourceVerifiedAccessTrustProvider) expandOIDCOptions(ctx context.Context, data verifiedAccessOIDCOptionsData) *awstypes.CreateVerifiedAccessTrustProviderOidcOptions {
+	return &awstypes.CreateVerifiedAccessTrustProviderOidcOptions{
+		AuthorizationEndpoint: flex.StringFromFramework(ctx, data.AuthorizationEndpoint),
+		ClientId:              flex.StringFromFramework(ctx, data.ClientId),
+		ClientSecret:          flex.StringFromFramework(ctx, data.ClientSecret),
+		Issuer:                flex.StringFromFramework(ctx, data.Issuer),
+		Scope:                 flex.StringFromFramework(ctx, data.Scope),
+		TokenEndpoint:         flex.StringFromFramework(ctx, data.TokenEndpoint),
+		UserInfoEndpoint:      flex.StringFromFramework(ctx, data.UserInfoEndpoint),
+	}
+}
+
+func (r *resourceVerifiedAccessTrustProvider) expandModifyOIDCOptions(ctx context.Context, data verifiedAccessOIDCOptionsData) *awstypes.ModifyVerifiedAccessTrustProviderOidcOptions {
+	return &awstypes.ModifyVerifiedAccessTrustProviderOidcOptions{
+		AuthorizationEndpoint: flex.StringFromFramework(ctx, data.AuthorizationEndpoint),
+		ClientId:              flex.StringFromFramework(ctx, data.ClientId),
+		ClientSecret:          flex.StringFromFramework(ctx, data.ClientSecret),
+		Issuer:                flex.StringFromFramework(ctx, data.Issuer),
+		Scope:                 flex.StringFromFramework(ctx, data.Scope),
+		TokenEndpoint:         flex.StringFromFramework(ctx, data.TokenEndpoint),
+		UserInfoEndpoint:      flex.StringFromFramework(ctx, data.UserInfoEndpoint),
+	}
+}
+
+func (r *resourceVerifiedAccessTrustProvider) flattenOIDCOptions(ctx context.Context, apiObject *awstypes.OidcOptions, prior types.List) types.List {
+	attributeTypes := flex.AttributeTypesMust[verifiedAccessOIDCOptionsData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	// The client secret is not returned by the API.
+	clientSecret := types.StringNull()
+	if v := apiObject.ClientSecret; v != nil {
+		clientSecret = flex.StringToFramework(ctx, v)
+	} else if !prior.IsNull() && !prior.IsUnknown() {
+		var data []verifiedAccessOIDCOptionsData
+		if diags := prior.ElementsAs(ctx, &data, false); !diags.HasError() && len(data) > 0 {
+			clientSecret = data[0].ClientSecret
+		}
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"authorization_endpoint": flex.StringToFramework(ctx, apiObject.AuthorizationEndpoint),
+			"client_id":              flex.StringToFramework(ctx, apiObject.ClientId),
+			"client_secret":          clientSecret,
+			"issuer":                 flex.StringToFramework(ctx, apiObject.Issuer),
+			"scope":                  flex.StringToFramework(ctx, apiObject.Scope),
+			"token_endpoint":         flex.StringToFramework(ctx, apiObject.TokenEndpoint),
+			"user_info_endpoint":     flex.StringToFramework(ctx, apiObject.UserInfoEndpoint),
+		}),
+	})
+}
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_VerifiedAccessTrustProvider.html.
+type resourceVerifiedAccessTrustProviderData struct {
+	Description                   types.String `tfsdk:"description"`
+	DeviceOptions                 types.List   `tfsdk:"device_options"`
+	DeviceTrustProviderType       types.String `tfsdk:"device_trust_provider_type"`
+	VerifiedAccessTrustProviderId types.String `tfsdk:"id"`
+	OidcOptions                   types.List   `tfsdk:"oidc_options"`
+	PolicyReferenceName           types.String `tfsdk:"policy_reference_name"`
+	Tags                          types.Map    `tfsdk:"tags"`
+	TagsAll                       types.Map    `tfsdk:"tags_all"`
+	TrustProviderType             types.String `tfsdk:"trust_provider_type"`
+	UserTrustProviderType         types.String `tfsdk:"user_trust_provider_type"`
+}
+
+type verifiedAccessDeviceOptionsData struct {
+	TenantId types.String `tfsdk:"tenant_id"`
+}
+
+type verifiedAccessOIDCOptionsData struct {
+	AuthorizationEndpoint types.String `tfsdk:"authorization_endpoint"`
+	ClientId              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	Issuer                types.String `tfsdk:"issuer"`
+	Scope                 types.String `tfsdk:"scope"`
+	TokenEndpoint         types.String `tfsdk:"token_endpoint"`
+	UserInfoEndpoint      types.String `tfsdk:"user_info_endpoint"`
+}
@@ -0,0 +1,299 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="VPC Block Public Access Exclusion")
+// @Tags(identifierAttribute="id")
+func newResourceVPCBlockPublicAccessExclusion(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceVPCBlockPublicAccessExclusion{}
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type resourceVPCBlockPublicAccessExclusion struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceVPCBlockPublicAccessExclusion) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_block_public_access_exclusion"
+}
+
+func (r *resourceVPCBlockPublicAccessExclusion) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"internet_gateway_exclusion_mode": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.InternetGatewayExclusionMode](),
+				},
+			},
+			"resource_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("subnet_id"), path.MatchRoot("vpc_id")),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"vpc_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceVPCBlockPublicAccessExclusion) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceVPCBlockPublicAccessExclusionData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.CreateVpcBlockPublicAccessExclusionInput{
+		InternetGatewayExclusionMode: awstypes.InternetGatewayExclusionMode(data.InternetGatewayExclusionMode.ValueString()),
+		SubnetId:                     flex.StringFromFramework(ctx, data.SubnetID),
+		TagSpecifications:            getTagSpecificationsInV2(ctx, awstypes.ResourceTypeVpcBlockPublicAccessExclusion),
+		VpcId:                        flex.StringFromFramework(ctx, data.VPCID),
+	}
+
+	output, err := conn.CreateVpcBlockPublicAccessExclusion(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating VPC Block Public Access Exclusion", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = flex.StringToFramework(ctx, output.VpcBlockPublicAccessExclusion.ExclusionId)
+
+	id := data.ID.ValueString()
+	exclusion, err := WaitVPCBlockPublicAccessExclusionCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Block Public Access Exclusion (%s) create", id), err.Error())
+
+		return
+	}
+
+	data.ResourceARN = flex.StringToFramework(ctx, exclusion.ResourceArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVPCBlockPublicAccessExclusion) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceVPCBlockPublicAccessExclusionData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.ID.ValueString()
+	exclusion, err := FindVPCBlockPublicAccessExclusionByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Block Public Access Exclusion (%s)", id), err.Error())
+
+		return
+	}
+
+	data.InternetGatewayExclusionMode = flex.StringValueToFramework(ctx, exclusion.InternetGatewayExclusionMode)
+	data.ResourceARN = flex.StringToFramework(ctx, exclusion.ResourceArn)
+
+	// The excluded VPC or subnet is only returned as part of the resource ARN.
+	resourceType, resourceID, err := vpcBlockPublicAccessExclusionParseResourceARN(aws.ToString(exclusion.ResourceArn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Block Public Access Exclusion (%s)", id), err.Error())
+
+		return
+	}
+
+	switch resourceType {
+	case "subnet":
+		data.SubnetID = types.StringValue(resourceID)
+		data.VPCID = types.StringNull()
+	case "vpc":
+		data.SubnetID = types.StringNull()
+		data.VPCID = types.StringValue(resourceID)
+	}
+
+	setTagsOutV2(ctx, exclusion.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVPCBlockPublicAccessExclusion) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceVPCBlockPublicAccessExclusionData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	if !new.InternetGatewayExclusionMode.Equal(old.InternetGatewayExclusionMode) {
+		id := new.ID.ValueString()
+		input := &ec2.ModifyVpcBlockPublicAccessExclusionInput{
+			ExclusionId:                  aws.String(id),
+			InternetGatewayExclusionMode: awstypes.InternetGatewayExclusionMode(new.InternetGatewayExclusionMode.ValueString()),
+		}
+
+		_, err := conn.ModifyVpcBlockPublicAccessExclusion(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Block Public Access Exclusion (%s)", id), err.Error())
+
+			return
+		}
+
+		if _, err := WaitVPCBlockPublicAccessExclusionUpdated(ctx, conn, id, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Block Public Access Exclusion (%s) update", id), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceVPCBlockPublicAccessExclusion) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceVPCBlockPublicAccessExclusionData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.ID.ValueString()
+	_, err := conn.DeleteVpcBlockPublicAccessExclusion(ctx, &ec2.DeleteVpcBlockPublicAccessExclusionInput{
+		ExclusionId: aws.String(id),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPCBlockPublicAccessExclusionIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Block Public Access Exclusion (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := WaitVPCBlockPublicAccessExclusionDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Block Public Access Exclusion (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceVPCBlockPublicAccessExclusion) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// vpcBlockPublicAccessExclusionParseResourceARN returns the resource type ("vpc" or "subnet") and ID from an excluded resource's ARN.
+func vpcBlockPublicAccessExclusionParseResourceARN(s string) (string, string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	resourceType, resourceID, ok := strings.Cut(v.Resource, "/")
+
+	if !ok || resourceID == "" {
+		return "", "", fmt.Errorf("unexpected format for resource ARN (%s)", s)
+	}
+
+	return resourceType, resourceID, nil
+}
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_VpcBlockPublicAccessExclusion.html.
+type resourceVPCBlockPublicAccessExclusionData struct {
+	ID                           types.String   `tfsdk:"id"`
+	InternetGatewayExclusionMode types.String   `tfsdk:"internet_gateway_exclusion_mode"`
+	ResourceARN                  types.String   `tfsdk:"resource_arn"`
+	SubnetID                     types.String   `tfsdk:"subnet_id"`
+	Tags                         types.Map      `tfsdk:"tags"`
+	TagsAll                      types.Map      `tfsdk:"tags_all"`
+	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
+	VPCID                        types.String   `tfsdk:"vpc_id"`
+}
@@ -0,0 +1,228 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="VPC Block Public Access Options")
+func newResourceVPCBlockPublicAccessOptions(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceVPCBlockPublicAccessOptions{}
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type resourceVPCBlockPublicAccessOptions struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_block_public_access_options"
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_account_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aws_region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"internet_gateway_block_mode": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.InternetGatewayBlockMode](),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceVPCBlockPublicAccessOptionsData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.ModifyVpcBlockPublicAccessOptionsInput{
+		InternetGatewayBlockMode: awstypes.InternetGatewayBlockMode(data.InternetGatewayBlockMode.ValueString()),
+	}
+
+	_, err := conn.ModifyVpcBlockPublicAccessOptions(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating VPC Block Public Access Options", err.Error())
+
+		return
+	}
+
+	options, err := WaitVPCBlockPublicAccessOptionsUpdated(ctx, conn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError("waiting for VPC Block Public Access Options create", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AWSAccountID = flex.StringToFramework(ctx, options.AwsAccountId)
+	data.AWSRegion = flex.StringToFramework(ctx, options.AwsRegion)
+	data.ID = types.StringValue(r.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceVPCBlockPublicAccessOptionsData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	options, err := FindVPCBlockPublicAccessOptions(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Block Public Access Options (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AWSAccountID = flex.StringToFramework(ctx, options.AwsAccountId)
+	data.AWSRegion = flex.StringToFramework(ctx, options.AwsRegion)
+	data.InternetGatewayBlockMode = flex.StringValueToFramework(ctx, options.InternetGatewayBlockMode)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceVPCBlockPublicAccessOptionsData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	if !new.InternetGatewayBlockMode.Equal(old.InternetGatewayBlockMode) {
+		input := &ec2.ModifyVpcBlockPublicAccessOptionsInput{
+			InternetGatewayBlockMode: awstypes.InternetGatewayBlockMode(new.InternetGatewayBlockMode.ValueString()),
+		}
+
+		_, err := conn.ModifyVpcBlockPublicAccessOptions(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Block Public Access Options (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := WaitVPCBlockPublicAccessOptionsUpdated(ctx, conn, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Block Public Access Options (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceVPCBlockPublicAccessOptionsData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// On deletion of this resource, set the VPC Block Public Access Options to the default value.
+	input := &ec2.ModifyVpcBlockPublicAccessOptionsInput{
+		InternetGatewayBlockMode: awstypes.InternetGatewayBlockModeOff,
+	}
+
+	_, err := conn.ModifyVpcBlockPublicAccessOptions(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Block Public Access Options (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := WaitVPCBlockPublicAccessOptionsUpdated(ctx, conn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Block Public Access Options (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_VpcBlockPublicAccessOptions.html.
+type resourceVPCBlockPublicAccessOptionsData struct {
+	AWSAccountID             types.String   `tfsdk:"aws_account_id"`
+	AWSRegion                types.String   `tfsdk:"aws_region"`
+	ID                       types.String   `tfsdk:"id"`
+	InternetGatewayBlockMode types.String   `tfsdk:"internet_gateway_block_mode"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,111 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccVPCBlockPublicAccessOptions_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic":  testAccVPCBlockPublicAccessOptions_basic,
+		"update": testAccVPCBlockPublicAccessOptions_update,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccVPCBlockPublicAccessOptions_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_block_public_access_options.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessOptionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessOptionsConfig_basic("block-bidirectional"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "aws_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_block_mode", "block-bidirectional"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVPCBlockPublicAccessOptions_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_block_public_access_options.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessOptionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessOptionsConfig_basic("block-ingress"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_block_mode", "block-ingress"),
+				),
+			},
+			{
+				Config: testAccVPCBlockPublicAccessOptionsConfig_basic("block-bidirectional"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_block_mode", "block-bidirectional"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCBlockPublicAccessOptionsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_block_public_access_options" {
+				continue
+			}
+
+			output, err := tfec2.FindVPCBlockPublicAccessOptions(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if mode := output.InternetGatewayBlockMode; mode != awstypes.InternetGatewayBlockModeOff {
+				return fmt.Errorf("VPC Block Public Access Options (%s) still enabled: %s", rs.Primary.ID, mode)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCBlockPublicAccessOptionsConfig_basic(mode string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc_block_public_access_options" "test" {
+  internet_gateway_block_mode = %[1]q
+}
+`, mode)
+}
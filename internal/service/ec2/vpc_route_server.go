@@ -0,0 +1,212 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpc_route_server", name="Route Server")
+// @Tags(identifierAttribute="id")
+func ResourceRouteServer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRouteServerCreate,
+		ReadWithoutTimeout:   resourceRouteServerRead,
+		UpdateWithoutTimeout: resourceRouteServerUpdate,
+		DeleteWithoutTimeout: resourceRouteServerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"amazon_side_asn": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4294967294),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"persist_routes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      awstypes.RouteServerPersistRoutesActionDisable,
+				ValidateFunc: validation.StringInSlice(enum.Slice(awstypes.RouteServerPersistRoutesActionDisable, awstypes.RouteServerPersistRoutesActionEnable), false),
+			},
+			"persist_routes_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"sns_notifications_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"sns_topic_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceRouteServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.CreateRouteServerInput{
+		AmazonSideAsn:           aws.Int64(int64(d.Get("amazon_side_asn").(int))),
+		PersistRoutes:           awstypes.RouteServerPersistRoutesAction(d.Get("persist_routes").(string)),
+		SnsNotificationsEnabled: aws.Bool(d.Get("sns_notifications_enabled").(bool)),
+		TagSpecifications:       getTagSpecificationsInV2(ctx, awstypes.ResourceTypeRouteServer),
+	}
+
+	if v, ok := d.GetOk("persist_routes_duration"); ok {
+		input.PersistRoutesDuration = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.CreateRouteServer(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating VPC Route Server: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.RouteServer.RouteServerId))
+
+	if _, err := WaitRouteServerCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRouteServerRead(ctx, d, meta)...)
+}
+
+func resourceRouteServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeServer, err := FindRouteServerByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Route Server %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Route Server (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   names.EC2,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("route-server/%s", d.Id()),
+	}.String()
+	d.Set("amazon_side_asn", routeServer.AmazonSideAsn)
+	d.Set("arn", arn)
+	switch routeServer.PersistRoutesState {
+	case awstypes.RouteServerPersistRoutesStateEnabled, awstypes.RouteServerPersistRoutesStateEnabling:
+		d.Set("persist_routes", awstypes.RouteServerPersistRoutesActionEnable)
+	default:
+		d.Set("persist_routes", awstypes.RouteServerPersistRoutesActionDisable)
+	}
+	d.Set("persist_routes_duration", routeServer.PersistRoutesDuration)
+	d.Set("sns_notifications_enabled", routeServer.SnsNotificationsEnabled)
+	d.Set("sns_topic_arn", routeServer.SnsTopicArn)
+
+	setTagsOutV2(ctx, routeServer.Tags)
+
+	return diags
+}
+
+func resourceRouteServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifyRouteServerInput{
+			RouteServerId: aws.String(d.Id()),
+		}
+
+		if d.HasChanges("persist_routes", "persist_routes_duration") {
+			input.PersistRoutes = awstypes.RouteServerPersistRoutesAction(d.Get("persist_routes").(string))
+
+			if v, ok := d.GetOk("persist_routes_duration"); ok && input.PersistRoutes == awstypes.RouteServerPersistRoutesActionEnable {
+				input.PersistRoutesDuration = aws.Int64(int64(v.(int)))
+			}
+		}
+
+		if d.HasChange("sns_notifications_enabled") {
+			input.SnsNotificationsEnabled = aws.Bool(d.Get("sns_notifications_enabled").(bool))
+		}
+
+		_, err := conn.ModifyRouteServer(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating VPC Route Server (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitRouteServerUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRouteServerRead(ctx, d, meta)...)
+}
+
+func resourceRouteServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting VPC Route Server: %s", d.Id())
+	_, err := conn.DeleteRouteServer(ctx, &ec2.DeleteRouteServerInput{
+		RouteServerId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting VPC Route Server (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitRouteServerDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
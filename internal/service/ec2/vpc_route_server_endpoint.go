@@ -0,0 +1,164 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpc_route_server_endpoint", name="Route Server Endpoint")
+// @Tags(identifierAttribute="id")
+func ResourceRouteServerEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRouteServerEndpointCreate,
+		ReadWithoutTimeout:   resourceRouteServerEndpointRead,
+		UpdateWithoutTimeout: resourceRouteServerEndpointUpdate,
+		DeleteWithoutTimeout: resourceRouteServerEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"eni_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"eni_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"route_server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRouteServerEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.CreateRouteServerEndpointInput{
+		RouteServerId:     aws.String(d.Get("route_server_id").(string)),
+		SubnetId:          aws.String(d.Get("subnet_id").(string)),
+		TagSpecifications: getTagSpecificationsInV2(ctx, awstypes.ResourceTypeRouteServerEndpoint),
+	}
+
+	output, err := conn.CreateRouteServerEndpoint(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating VPC Route Server Endpoint: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.RouteServerEndpoint.RouteServerEndpointId))
+
+	if _, err := WaitRouteServerEndpointCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRouteServerEndpointRead(ctx, d, meta)...)
+}
+
+func resourceRouteServerEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	endpoint, err := FindRouteServerEndpointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Route Server Endpoint %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Route Server Endpoint (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   names.EC2,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("route-server-endpoint/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("eni_address", endpoint.EniAddress)
+	d.Set("eni_id", endpoint.EniId)
+	d.Set("route_server_id", endpoint.RouteServerId)
+	d.Set("subnet_id", endpoint.SubnetId)
+	d.Set("vpc_id", endpoint.VpcId)
+
+	setTagsOutV2(ctx, endpoint.Tags)
+
+	return diags
+}
+
+func resourceRouteServerEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceRouteServerEndpointRead(ctx, d, meta)
+}
+
+func resourceRouteServerEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting VPC Route Server Endpoint: %s", d.Id())
+	_, err := conn.DeleteRouteServerEndpoint(ctx, &ec2.DeleteRouteServerEndpointInput{
+		RouteServerEndpointId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerEndpointIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting VPC Route Server Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitRouteServerEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
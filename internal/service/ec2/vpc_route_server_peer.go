@@ -0,0 +1,245 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpc_route_server_peer", name="Route Server Peer")
+// @Tags(identifierAttribute="id")
+func ResourceRouteServerPeer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRouteServerPeerCreate,
+		ReadWithoutTimeout:   resourceRouteServerPeerRead,
+		UpdateWithoutTimeout: resourceRouteServerPeerUpdate,
+		DeleteWithoutTimeout: resourceRouteServerPeerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_options": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"peer_asn": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 4294967294),
+						},
+						"peer_liveness_detection": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          awstypes.RouteServerPeerLivenessModeBgpKeepalive,
+							ValidateDiagFunc: enum.Validate[awstypes.RouteServerPeerLivenessMode](),
+						},
+					},
+				},
+			},
+			"endpoint_eni_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_eni_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"route_server_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRouteServerPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.CreateRouteServerPeerInput{
+		PeerAddress:           aws.String(d.Get("peer_address").(string)),
+		RouteServerEndpointId: aws.String(d.Get("route_server_endpoint_id").(string)),
+		TagSpecifications:     getTagSpecificationsInV2(ctx, awstypes.ResourceTypeRouteServerPeer),
+	}
+
+	if v, ok := d.GetOk("bgp_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BgpOptions = expandRouteServerBGPOptionsRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateRouteServerPeer(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating VPC Route Server Peer: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.RouteServerPeer.RouteServerPeerId))
+
+	if _, err := WaitRouteServerPeerCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server Peer (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRouteServerPeerRead(ctx, d, meta)...)
+}
+
+func resourceRouteServerPeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	peer, err := FindRouteServerPeerByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Route Server Peer %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Route Server Peer (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   names.EC2,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("route-server-peer/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	if peer.BgpOptions != nil {
+		if err := d.Set("bgp_options", []interface{}{flattenRouteServerBGPOptions(peer.BgpOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting bgp_options: %s", err)
+		}
+	} else {
+		d.Set("bgp_options", nil)
+	}
+	d.Set("endpoint_eni_address", peer.EndpointEniAddress)
+	d.Set("endpoint_eni_id", peer.EndpointEniId)
+	d.Set("peer_address", peer.PeerAddress)
+	d.Set("route_server_endpoint_id", peer.RouteServerEndpointId)
+	d.Set("route_server_id", peer.RouteServerId)
+	d.Set("subnet_id", peer.SubnetId)
+	d.Set("vpc_id", peer.VpcId)
+
+	setTagsOutV2(ctx, peer.Tags)
+
+	return diags
+}
+
+func resourceRouteServerPeerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceRouteServerPeerRead(ctx, d, meta)
+}
+
+func resourceRouteServerPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting VPC Route Server Peer: %s", d.Id())
+	_, err := conn.DeleteRouteServerPeer(ctx, &ec2.DeleteRouteServerPeerInput{
+		RouteServerPeerId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerPeerIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting VPC Route Server Peer (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitRouteServerPeerDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server Peer (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandRouteServerBGPOptionsRequest(tfMap map[string]interface{}) *awstypes.RouteServerBgpOptionsRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.RouteServerBgpOptionsRequest{}
+
+	if v, ok := tfMap["peer_asn"].(int); ok && v != 0 {
+		apiObject.PeerAsn = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["peer_liveness_detection"].(string); ok && v != "" {
+		apiObject.PeerLivenessDetection = awstypes.RouteServerPeerLivenessMode(v)
+	}
+
+	return apiObject
+}
+
+func flattenRouteServerBGPOptions(apiObject *awstypes.RouteServerBgpOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"peer_liveness_detection": string(apiObject.PeerLivenessDetection),
+	}
+
+	if v := apiObject.PeerAsn; v != nil {
+		tfMap["peer_asn"] = aws.ToInt64(v)
+	}
+
+	return tfMap
+}
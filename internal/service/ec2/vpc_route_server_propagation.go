@@ -0,0 +1,154 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_vpc_route_server_propagation", name="Route Server Propagation")
+func ResourceRouteServerPropagation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRouteServerPropagationCreate,
+		ReadWithoutTimeout:   resourceRouteServerPropagationRead,
+		DeleteWithoutTimeout: resourceRouteServerPropagationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route_server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRouteServerPropagationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeServerID := d.Get("route_server_id").(string)
+	routeTableID := d.Get("route_table_id").(string)
+	id := RouteServerPropagationCreateResourceID(routeServerID, routeTableID)
+	input := &ec2.EnableRouteServerPropagationInput{
+		RouteServerId: aws.String(routeServerID),
+		RouteTableId:  aws.String(routeTableID),
+	}
+
+	_, err := conn.EnableRouteServerPropagation(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating VPC Route Server Propagation (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := WaitRouteServerPropagationCreated(ctx, conn, routeServerID, routeTableID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server Propagation (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRouteServerPropagationRead(ctx, d, meta)...)
+}
+
+func resourceRouteServerPropagationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeServerID, routeTableID, err := RouteServerPropagationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Route Server Propagation (%s): %s", d.Id(), err)
+	}
+
+	propagation, err := FindRouteServerPropagationByTwoPartKey(ctx, conn, routeServerID, routeTableID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Route Server Propagation %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Route Server Propagation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("route_server_id", propagation.RouteServerId)
+	d.Set("route_table_id", propagation.RouteTableId)
+
+	return diags
+}
+
+func resourceRouteServerPropagationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeServerID, routeTableID, err := RouteServerPropagationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting VPC Route Server Propagation (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting VPC Route Server Propagation: %s", d.Id())
+	_, err = conn.DisableRouteServerPropagation(ctx, &ec2.DisableRouteServerPropagationInput{
+		RouteServerId: aws.String(routeServerID),
+		RouteTableId:  aws.String(routeTableID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound, errCodeInvalidRouteTableIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting VPC Route Server Propagation (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitRouteServerPropagationDeleted(ctx, conn, routeServerID, routeTableID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server Propagation (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const routeServerPropagationIDSeparator = "_"
+
+func RouteServerPropagationCreateResourceID(routeServerID, routeTableID string) string {
+	parts := []string{routeServerID, routeTableID}
+	id := strings.Join(parts, routeServerPropagationIDSeparator)
+
+	return id
+}
+
+func RouteServerPropagationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, routeServerPropagationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ROUTE-SERVER-ID%[2]sROUTE-TABLE-ID", id, routeServerPropagationIDSeparator)
+}
@@ -0,0 +1,154 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_vpc_route_server_vpc_association", name="Route Server VPC Association")
+func ResourceRouteServerVPCAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRouteServerVPCAssociationCreate,
+		ReadWithoutTimeout:   resourceRouteServerVPCAssociationRead,
+		DeleteWithoutTimeout: resourceRouteServerVPCAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route_server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRouteServerVPCAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeServerID := d.Get("route_server_id").(string)
+	vpcID := d.Get("vpc_id").(string)
+	id := RouteServerVPCAssociationCreateResourceID(routeServerID, vpcID)
+	input := &ec2.AssociateRouteServerInput{
+		RouteServerId: aws.String(routeServerID),
+		VpcId:         aws.String(vpcID),
+	}
+
+	_, err := conn.AssociateRouteServer(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating VPC Route Server VPC Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := WaitRouteServerVPCAssociationCreated(ctx, conn, routeServerID, vpcID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server VPC Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRouteServerVPCAssociationRead(ctx, d, meta)...)
+}
+
+func resourceRouteServerVPCAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeServerID, vpcID, err := RouteServerVPCAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Route Server VPC Association (%s): %s", d.Id(), err)
+	}
+
+	association, err := FindRouteServerVPCAssociationByTwoPartKey(ctx, conn, routeServerID, vpcID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Route Server VPC Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Route Server VPC Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("route_server_id", association.RouteServerId)
+	d.Set("vpc_id", association.VpcId)
+
+	return diags
+}
+
+func resourceRouteServerVPCAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeServerID, vpcID, err := RouteServerVPCAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting VPC Route Server VPC Association (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting VPC Route Server VPC Association: %s", d.Id())
+	_, err = conn.DisassociateRouteServer(ctx, &ec2.DisassociateRouteServerInput{
+		RouteServerId: aws.String(routeServerID),
+		VpcId:         aws.String(vpcID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound, errCodeInvalidVPCIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting VPC Route Server VPC Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitRouteServerVPCAssociationDeleted(ctx, conn, routeServerID, vpcID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Route Server VPC Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const routeServerVPCAssociationIDSeparator = "_"
+
+func RouteServerVPCAssociationCreateResourceID(routeServerID, vpcID string) string {
+	parts := []string{routeServerID, vpcID}
+	id := strings.Join(parts, routeServerVPCAssociationIDSeparator)
+
+	return id
+}
+
+func RouteServerVPCAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, routeServerVPCAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ROUTE-SERVER-ID%[2]sVPC-ID", id, routeServerVPCAssociationIDSeparator)
+}
@@ -21,10 +21,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_security_group", name="Security Group")
@@ -63,7 +66,12 @@ func ResourceSecurityGroup() *schema.Resource {
 				Default:      "Managed by Terraform",
 				ValidateFunc: validation.StringLenBetween(0, 255),
 			},
-			"egress":  securityGroupRuleSetNestedBlock,
+			"egress": securityGroupRuleSetNestedBlock,
+			"exclusive_rules": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"ingress": securityGroupRuleSetNestedBlock,
 			"name": {
 				Type:          schema.TypeString,
@@ -98,6 +106,11 @@ func ResourceSecurityGroup() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"unmanaged_rule_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -257,6 +270,7 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
 	sg, err := FindSecurityGroupByID(ctx, conn, d.Id())
@@ -271,16 +285,42 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("reading Security Group (%s): %s", d.Id(), err)
 	}
 
-	remoteIngressRules := SecurityGroupIPPermGather(d.Id(), sg.IpPermissions, sg.OwnerId)
-	remoteEgressRules := SecurityGroupIPPermGather(d.Id(), sg.IpPermissionsEgress, sg.OwnerId)
-
 	localIngressRules := d.Get("ingress").(*schema.Set).List()
 	localEgressRules := d.Get("egress").(*schema.Set).List()
 
+	ingressPermissions, egressPermissions := sg.IpPermissions, sg.IpPermissionsEgress
+	var unmanagedRuleIDs []string
+
+	if d.Get("exclusive_rules").(bool) {
+		rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Security Group (%s) Rules: %s", d.Id(), err)
+		}
+
+		// Rules owned by other Terraform resources are left out of the in-line rule set.
+		// All other rules are merged into state below and so are planned for removal.
+		var ownedRules []*ec2.SecurityGroupRule
+		ownedRules, unmanagedRuleIDs = classifySecurityGroupRules(d.Id(), rules, localIngressRules, localEgressRules)
+		ingressPermissions = removeSecurityGroupRulesFromIPPermissions(ingressPermissions, ownedRules, false)
+		egressPermissions = removeSecurityGroupRulesFromIPPermissions(egressPermissions, ownedRules, true)
+
+		if len(unmanagedRuleIDs) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Security Group (%s) has rules not managed by Terraform", d.Id()),
+				Detail:   fmt.Sprintf("The following rules aren't owned by this or another Terraform resource: %s", strings.Join(unmanagedRuleIDs, ", ")),
+			})
+		}
+	}
+
+	remoteIngressRules := SecurityGroupIPPermGather(d.Id(), ingressPermissions, sg.OwnerId)
+	remoteEgressRules := SecurityGroupIPPermGather(d.Id(), egressPermissions, sg.OwnerId)
+
 	// Loop through the local state of rules, doing a match against the remote
 	// ruleSet we built above.
-	ingressRules := MatchRules("ingress", localIngressRules, remoteIngressRules)
-	egressRules := MatchRules("egress", localEgressRules, remoteEgressRules)
+	ingressRules := MatchRules(securityGroupRuleTypeIngress, localIngressRules, remoteIngressRules)
+	egressRules := MatchRules(securityGroupRuleTypeEgress, localEgressRules, remoteEgressRules)
 
 	ownerID := aws.StringValue(sg.OwnerId)
 	arn := arn.ARN{
@@ -305,9 +345,11 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("setting egress: %s", err)
 	}
 
+	d.Set("unmanaged_rule_ids", unmanagedRuleIDs)
+
 	setTagsOut(ctx, sg.Tags)
 
-	return nil
+	return diags
 }
 
 func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -331,6 +373,12 @@ func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("updating Security Group (%s) %s rules: %s", d.Id(), securityGroupRuleTypeEgress, err)
 	}
 
+	if d.Get("exclusive_rules").(bool) || d.HasChange("exclusive_rules") {
+		if err := updateSecurityGroupInlineRulesManagedTag(ctx, conn, d); err != nil {
+			return diag.Errorf("updating Security Group (%s) rules: %s", d.Id(), err)
+		}
+	}
+
 	return resourceSecurityGroupRead(ctx, d, meta)
 }
 
@@ -798,6 +846,211 @@ func updateSecurityGroupRules(ctx context.Context, conn *ec2.EC2, d *schema.Reso
 	return nil
 }
 
+// securityGroupRuleIsManaged returns whether the security group rule is marked as owned by a Terraform resource.
+func securityGroupRuleIsManaged(apiObject *ec2.SecurityGroupRule) bool {
+	for _, v := range apiObject.Tags {
+		if aws.StringValue(v.Key) == securityGroupRuleManagedTagKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// classifySecurityGroupRules returns the security group rules that don't match one of the specified in-line
+// ingress or egress rules, split into the rules owned by another Terraform resource and the IDs of the rules with no owner.
+func classifySecurityGroupRules(groupID string, apiObjects []*ec2.SecurityGroupRule, ingress, egress []interface{}) ([]*ec2.SecurityGroupRule, []string) {
+	var owned []*ec2.SecurityGroupRule
+	var unmanagedIDs []string
+
+	for _, apiObject := range apiObjects {
+		inline := ingress
+		if aws.BoolValue(apiObject.IsEgress) {
+			inline = egress
+		}
+
+		if securityGroupRuleMatchesInlineRules(groupID, apiObject, inline) {
+			continue
+		}
+
+		if securityGroupRuleIsManaged(apiObject) {
+			owned = append(owned, apiObject)
+		} else {
+			unmanagedIDs = append(unmanagedIDs, aws.StringValue(apiObject.SecurityGroupRuleId))
+		}
+	}
+
+	return owned, unmanagedIDs
+}
+
+// removeSecurityGroupRulesFromIPPermissions returns the IP permissions without the sources of the specified
+// security group rules. Permissions left without any source are dropped.
+func removeSecurityGroupRulesFromIPPermissions(apiObjects []*ec2.IpPermission, rules []*ec2.SecurityGroupRule, egress bool) []*ec2.IpPermission {
+	var result []*ec2.IpPermission
+
+	for _, apiObject := range apiObjects {
+		protocol := ProtocolForValue(aws.StringValue(apiObject.IpProtocol))
+		var matched []*ec2.SecurityGroupRule
+
+		for _, rule := range rules {
+			if aws.BoolValue(rule.IsEgress) != egress || ProtocolForValue(aws.StringValue(rule.IpProtocol)) != protocol {
+				continue
+			}
+
+			// Ports are ignored for "all traffic" rules.
+			if protocol != "-1" && (aws.Int64Value(rule.FromPort) != aws.Int64Value(apiObject.FromPort) || aws.Int64Value(rule.ToPort) != aws.Int64Value(apiObject.ToPort)) {
+				continue
+			}
+
+			matched = append(matched, rule)
+		}
+
+		if len(matched) == 0 {
+			result = append(result, apiObject)
+			continue
+		}
+
+		permission := &ec2.IpPermission{
+			FromPort:   apiObject.FromPort,
+			IpProtocol: apiObject.IpProtocol,
+			ToPort:     apiObject.ToPort,
+		}
+
+		for _, v := range apiObject.IpRanges {
+			if !slices.ContainsFunc(matched, func(rule *ec2.SecurityGroupRule) bool {
+				return rule.CidrIpv4 != nil && aws.StringValue(rule.CidrIpv4) == aws.StringValue(v.CidrIp)
+			}) {
+				permission.IpRanges = append(permission.IpRanges, v)
+			}
+		}
+
+		for _, v := range apiObject.Ipv6Ranges {
+			if !slices.ContainsFunc(matched, func(rule *ec2.SecurityGroupRule) bool {
+				return rule.CidrIpv6 != nil && aws.StringValue(rule.CidrIpv6) == aws.StringValue(v.CidrIpv6)
+			}) {
+				permission.Ipv6Ranges = append(permission.Ipv6Ranges, v)
+			}
+		}
+
+		for _, v := range apiObject.PrefixListIds {
+			if !slices.ContainsFunc(matched, func(rule *ec2.SecurityGroupRule) bool {
+				return rule.PrefixListId != nil && aws.StringValue(rule.PrefixListId) == aws.StringValue(v.PrefixListId)
+			}) {
+				permission.PrefixListIds = append(permission.PrefixListIds, v)
+			}
+		}
+
+		for _, v := range apiObject.UserIdGroupPairs {
+			if !slices.ContainsFunc(matched, func(rule *ec2.SecurityGroupRule) bool {
+				return rule.ReferencedGroupInfo != nil && aws.StringValue(rule.ReferencedGroupInfo.GroupId) == aws.StringValue(v.GroupId)
+			}) {
+				permission.UserIdGroupPairs = append(permission.UserIdGroupPairs, v)
+			}
+		}
+
+		if len(permission.IpRanges)+len(permission.Ipv6Ranges)+len(permission.PrefixListIds)+len(permission.UserIdGroupPairs) > 0 {
+			result = append(result, permission)
+		}
+	}
+
+	return result
+}
+
+// updateSecurityGroupInlineRulesManagedTag marks the security group's in-line rules as owned by Terraform
+// while exclusive_rules is enabled and removes the marker once it is disabled.
+func updateSecurityGroupInlineRulesManagedTag(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData) error {
+	rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("reading Security Group (%s) Rules: %w", d.Id(), err)
+	}
+
+	exclusive := d.Get("exclusive_rules").(bool)
+	ingress := d.Get("ingress").(*schema.Set).List()
+	egress := d.Get("egress").(*schema.Set).List()
+	tags := map[string]string{securityGroupRuleManagedTagKey: "true"}
+
+	for _, rule := range rules {
+		inline := ingress
+		if aws.BoolValue(rule.IsEgress) {
+			inline = egress
+		}
+
+		if !securityGroupRuleMatchesInlineRules(d.Id(), rule, inline) {
+			continue
+		}
+
+		id := aws.StringValue(rule.SecurityGroupRuleId)
+
+		switch managed := securityGroupRuleIsManaged(rule); {
+		case exclusive && !managed:
+			if err := createTags(ctx, conn, id, Tags(tftags.New(ctx, tags))); err != nil {
+				return fmt.Errorf("marking Security Group Rule (%s) as managed: %w", id, err)
+			}
+		case !exclusive && managed:
+			if err := updateTags(ctx, conn, id, tags, nil); err != nil {
+				return fmt.Errorf("unmarking Security Group Rule (%s) as managed: %w", id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// securityGroupRuleMatchesInlineRules returns whether the security group rule is covered by one of the in-line rules.
+func securityGroupRuleMatchesInlineRules(groupID string, apiObject *ec2.SecurityGroupRule, tfList []interface{}) bool {
+	protocol := ProtocolForValue(aws.StringValue(apiObject.IpProtocol))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if ProtocolForValue(tfMap["protocol"].(string)) != protocol {
+			continue
+		}
+
+		// Ports are ignored for "all traffic" rules.
+		if protocol != "-1" {
+			if int64(tfMap["from_port"].(int)) != aws.Int64Value(apiObject.FromPort) || int64(tfMap["to_port"].(int)) != aws.Int64Value(apiObject.ToPort) {
+				continue
+			}
+		}
+
+		switch {
+		case apiObject.CidrIpv4 != nil:
+			if slices.Contains(flex.ExpandStringValueList(tfMap["cidr_blocks"].([]interface{})), aws.StringValue(apiObject.CidrIpv4)) {
+				return true
+			}
+		case apiObject.CidrIpv6 != nil:
+			if slices.Contains(flex.ExpandStringValueList(tfMap["ipv6_cidr_blocks"].([]interface{})), aws.StringValue(apiObject.CidrIpv6)) {
+				return true
+			}
+		case apiObject.PrefixListId != nil:
+			if slices.Contains(flex.ExpandStringValueList(tfMap["prefix_list_ids"].([]interface{})), aws.StringValue(apiObject.PrefixListId)) {
+				return true
+			}
+		case apiObject.ReferencedGroupInfo != nil:
+			referencedGroupID := aws.StringValue(apiObject.ReferencedGroupInfo.GroupId)
+
+			if referencedGroupID == groupID && tfMap["self"].(bool) {
+				return true
+			}
+
+			for _, v := range tfMap["security_groups"].(*schema.Set).List() {
+				// Security groups in other accounts are referenced as "account-id/group-id".
+				if v := v.(string); v == referencedGroupID || strings.HasSuffix(v, "/"+referencedGroupID) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
 // Takes the result of flatmap.Expand for an array of ingress/egress security
 // group rules and returns EC2 API compatible objects. This function will error
 // if it finds invalid permissions input, namely a protocol of "-1" with either
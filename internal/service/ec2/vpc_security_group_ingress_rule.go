@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -127,6 +128,11 @@ func (r *resourceSecurityGroupRule) Schema(ctx context.Context, req resource.Sch
 					NormalizeIPProtocol(),
 				},
 			},
+			"mark_managed": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"prefix_list_id": schema.StringAttribute{
 				Optional: true,
 			},
@@ -176,8 +182,16 @@ func (r *resourceSecurityGroupRule) Create(ctx context.Context, request resource
 
 	data.ID = types.StringValue(securityGroupRuleID)
 
+	tags := getTagsIn(ctx)
+	if data.MarkManaged.ValueBool() {
+		tags = append(tags, &ec2.Tag{
+			Key:   aws.String(securityGroupRuleManagedTagKey),
+			Value: aws.String("true"),
+		})
+	}
+
 	conn := r.Meta().EC2Conn(ctx)
-	if err := createTags(ctx, conn, data.ID.ValueString(), getTagsIn(ctx)); err != nil {
+	if err := createTags(ctx, conn, data.ID.ValueString(), tags); err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("setting VPC Security Group Rule (%s) tags", data.ID.ValueString()), err.Error())
 
 		return
@@ -221,6 +235,7 @@ func (r *resourceSecurityGroupRule) Read(ctx context.Context, request resource.R
 	data.CIDRIPv6 = flex.StringToFramework(ctx, output.CidrIpv6)
 	data.Description = flex.StringToFramework(ctx, output.Description)
 	data.IPProtocol = flex.StringToFramework(ctx, output.IpProtocol)
+	data.MarkManaged = types.BoolValue(securityGroupRuleIsManaged(output))
 	data.PrefixListID = flex.StringToFramework(ctx, output.PrefixListId)
 	data.ReferencedSecurityGroupID = r.flattenReferencedSecurityGroup(ctx, output.ReferencedGroupInfo)
 	data.SecurityGroupID = flex.StringToFramework(ctx, output.GroupId)
@@ -238,7 +253,8 @@ func (r *resourceSecurityGroupRule) Read(ctx context.Context, request resource.R
 		data.ToPort = flex.Int64ToFramework(ctx, output.ToPort)
 	}
 
-	setTagsOut(ctx, output.Tags)
+	// The managed marker isn't a user tag.
+	setTagsOut(ctx, Tags(KeyValueTags(ctx, output.Tags).Ignore(tftags.New(ctx, []string{securityGroupRuleManagedTagKey}))))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -285,6 +301,22 @@ func (r *resourceSecurityGroupRule) Update(ctx context.Context, request resource
 		}
 	}
 
+	if !new.MarkManaged.Equal(old.MarkManaged) {
+		oldTags, newTags := map[string]string{}, map[string]string{}
+		if old.MarkManaged.ValueBool() {
+			oldTags[securityGroupRuleManagedTagKey] = "true"
+		}
+		if new.MarkManaged.ValueBool() {
+			newTags[securityGroupRuleManagedTagKey] = "true"
+		}
+
+		if err := updateTags(ctx, conn, new.ID.ValueString(), oldTags, newTags); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group Rule (%s) managed marker", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -445,6 +477,7 @@ type resourceSecurityGroupRuleData struct {
 	FromPort                  types.Int64  `tfsdk:"from_port"`
 	ID                        types.String `tfsdk:"id"`
 	IPProtocol                types.String `tfsdk:"ip_protocol"`
+	MarkManaged               types.Bool   `tfsdk:"mark_managed"`
 	PrefixListID              types.String `tfsdk:"prefix_list_id"`
 	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
 	SecurityGroupID           types.String `tfsdk:"security_group_id"`
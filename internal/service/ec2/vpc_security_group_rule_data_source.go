@@ -89,7 +89,7 @@ func (d *dataSourceSecurityGroupRule) Read(ctx context.Context, request datasour
 	}
 
 	conn := d.Meta().EC2Conn(ctx)
-	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig(ctx)
 
 	input := &ec2.DescribeSecurityGroupRulesInput{
 		Filters: BuildCustomFilters(ctx, data.Filters),
@@ -124,7 +124,7 @@ func (d *dataSourceSecurityGroupRule) Read(ctx context.Context, request datasour
 	data.ReferencedSecurityGroupID = d.flattenReferencedSecurityGroup(ctx, output.ReferencedGroupInfo)
 	data.SecurityGroupID = flex.StringToFramework(ctx, output.GroupId)
 	data.SecurityGroupRuleID = flex.StringToFramework(ctx, output.SecurityGroupRuleId)
-	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Ignore(tftags.New(ctx, []string{securityGroupRuleManagedTagKey})).Map())
 	data.ToPort = flex.Int64ToFramework(ctx, output.ToPort)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -4,6 +4,9 @@ import (
 	"log"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
@@ -711,3 +714,112 @@ func TestRulesMixedMatching(t *testing.T) {
 		}
 	}
 }
+
+func TestClassifySecurityGroupRules(t *testing.T) {
+	t.Parallel()
+
+	const groupID = "sg-1234"
+	ingress := []interface{}{
+		map[string]interface{}{
+			"from_port":        80,
+			"to_port":          80,
+			"protocol":         "tcp",
+			"cidr_blocks":      []interface{}{"10.0.0.0/16"},
+			"ipv6_cidr_blocks": []interface{}{},
+			"prefix_list_ids":  []interface{}{},
+			"security_groups":  schema.NewSet(schema.HashString, []interface{}{"123456789012/sg-5678"}),
+			"self":             true,
+		},
+	}
+	egress := []interface{}{
+		map[string]interface{}{
+			"from_port":        0,
+			"to_port":          0,
+			"protocol":         "all",
+			"cidr_blocks":      []interface{}{},
+			"ipv6_cidr_blocks": []interface{}{"::/0"},
+			"prefix_list_ids":  []interface{}{},
+			"security_groups":  schema.NewSet(schema.HashString, nil),
+			"self":             false,
+		},
+	}
+	rules := []*ec2.SecurityGroupRule{
+		// In-line CIDR rule.
+		{SecurityGroupRuleId: aws.String("sgr-1"), IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"), FromPort: aws.Int64(80), ToPort: aws.Int64(80), CidrIpv4: aws.String("10.0.0.0/16")},
+		// In-line self reference.
+		{SecurityGroupRuleId: aws.String("sgr-2"), IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"), FromPort: aws.Int64(80), ToPort: aws.Int64(80), ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{GroupId: aws.String(groupID)}},
+		// In-line cross-account security group reference.
+		{SecurityGroupRuleId: aws.String("sgr-3"), IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"), FromPort: aws.Int64(80), ToPort: aws.Int64(80), ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{GroupId: aws.String("sg-5678")}},
+		// In-line "all traffic" rule.
+		{SecurityGroupRuleId: aws.String("sgr-4"), IsEgress: aws.Bool(true), IpProtocol: aws.String("-1"), FromPort: aws.Int64(-1), ToPort: aws.Int64(-1), CidrIpv6: aws.String("::/0")},
+		// Owned by a separate rule resource.
+		{SecurityGroupRuleId: aws.String("sgr-5"), IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"), FromPort: aws.Int64(22), ToPort: aws.Int64(22), CidrIpv4: aws.String("10.0.0.0/8"), Tags: []*ec2.Tag{{Key: aws.String("terraform-provider-aws:managed"), Value: aws.String("true")}}},
+		// Different port.
+		{SecurityGroupRuleId: aws.String("sgr-6"), IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"), FromPort: aws.Int64(443), ToPort: aws.Int64(443), CidrIpv4: aws.String("10.0.0.0/16")},
+		// Different CIDR block.
+		{SecurityGroupRuleId: aws.String("sgr-7"), IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"), FromPort: aws.Int64(80), ToPort: aws.Int64(80), CidrIpv4: aws.String("10.1.0.0/16")},
+		// Matches an ingress rule but is an egress rule.
+		{SecurityGroupRuleId: aws.String("sgr-8"), IsEgress: aws.Bool(true), IpProtocol: aws.String("tcp"), FromPort: aws.Int64(80), ToPort: aws.Int64(80), CidrIpv4: aws.String("10.0.0.0/16")},
+		// Prefix list rule.
+		{SecurityGroupRuleId: aws.String("sgr-9"), IsEgress: aws.Bool(true), IpProtocol: aws.String("-1"), FromPort: aws.Int64(-1), ToPort: aws.Int64(-1), PrefixListId: aws.String("pl-1234")},
+	}
+
+	owned, unmanagedIDs := tfec2.ClassifySecurityGroupRules(groupID, rules, ingress, egress)
+
+	if got, want := len(owned), 1; got != want {
+		t.Fatalf("owned rules: got %d, want %d", got, want)
+	}
+	if got, want := aws.StringValue(owned[0].SecurityGroupRuleId), "sgr-5"; got != want {
+		t.Errorf("owned rule: got %s, want %s", got, want)
+	}
+
+	if diff := cmp.Diff(unmanagedIDs, []string{"sgr-6", "sgr-7", "sgr-8", "sgr-9"}); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestRemoveSecurityGroupRulesFromIPPermissions(t *testing.T) {
+	t.Parallel()
+
+	permissions := []*ec2.IpPermission{
+		{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(80),
+			ToPort:     aws.Int64(80),
+			IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/16")}, {CidrIp: aws.String("10.1.0.0/16")}},
+		},
+		{
+			IpProtocol:       aws.String("tcp"),
+			FromPort:         aws.Int64(22),
+			ToPort:           aws.Int64(22),
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-5678")}},
+		},
+		{
+			IpProtocol:    aws.String("-1"),
+			FromPort:      aws.Int64(-1),
+			ToPort:        aws.Int64(-1),
+			PrefixListIds: []*ec2.PrefixListId{{PrefixListId: aws.String("pl-1234")}},
+		},
+	}
+	rules := []*ec2.SecurityGroupRule{
+		{IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"), FromPort: aws.Int64(80), ToPort: aws.Int64(80), CidrIpv4: aws.String("10.1.0.0/16")},
+		{IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"), FromPort: aws.Int64(22), ToPort: aws.Int64(22), ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{GroupId: aws.String("sg-5678")}},
+		// Egress rules are ignored.
+		{IsEgress: aws.Bool(true), IpProtocol: aws.String("-1"), FromPort: aws.Int64(-1), ToPort: aws.Int64(-1), PrefixListId: aws.String("pl-1234")},
+	}
+
+	got := tfec2.RemoveSecurityGroupRulesFromIPPermissions(permissions, rules, false)
+	want := []*ec2.IpPermission{
+		{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(80),
+			ToPort:     aws.Int64(80),
+			IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/16")}},
+		},
+		permissions[2],
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
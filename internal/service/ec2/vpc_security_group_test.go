@@ -1336,6 +1336,90 @@ func TestAccVPCSecurityGroup_ingressMode(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroup_exclusiveRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group ec2.SecurityGroup
+	resourceName := "aws_security_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupConfig_exclusiveRulesBlocks(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_rules", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"exclusive_rules", "revoke_rules_on_delete"},
+			},
+			// A rule added outside of Terraform is revoked.
+			{
+				PreConfig: testAccAuthorizeSecurityGroupIngress(ctx, t, &group, 443),
+				Config:    testAccVPCSecurityGroupConfig_exclusiveRulesBlocks(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_rule_ids.#", "0"),
+					testAccCheckSecurityGroupRuleCount(ctx, &group, 1, 0),
+				),
+			},
+			// Omitting the in-line rules keeps the existing ones rather than revoking them.
+			{
+				Config: testAccVPCSecurityGroupConfig_exclusiveRulesNoBlocks(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_rule_ids.#", "0"),
+					testAccCheckSecurityGroupRuleCount(ctx, &group, 1, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroup_exclusiveRulesSeparateRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group ec2.SecurityGroup
+	resourceName := "aws_security_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupConfig_exclusiveRulesSeparateRules(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+				),
+			},
+			// Rules owned by separate rule resources are neither reported nor revoked.
+			{
+				Config: testAccVPCSecurityGroupConfig_exclusiveRulesSeparateRules(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_rule_ids.#", "0"),
+					testAccCheckSecurityGroupRuleCount(ctx, &group, 2, 0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCSecurityGroup_ruleGathering(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group ec2.SecurityGroup
@@ -2890,6 +2974,26 @@ func testAccCheckSecurityGroupRuleLimit(n string, v *int) resource.TestCheckFunc
 	}
 }
 
+func testAccAuthorizeSecurityGroupIngress(ctx context.Context, t *testing.T, group *ec2.SecurityGroup, port int64) func() {
+	return func() {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: group.GroupId,
+			IpPermissions: []*ec2.IpPermission{{
+				FromPort:   aws.Int64(port),
+				IpProtocol: aws.String("tcp"),
+				IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/16")}},
+				ToPort:     aws.Int64(port),
+			}},
+		}
+
+		if _, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			t.Fatalf("authorizing Security Group (%s) ingress: %s", aws.StringValue(group.GroupId), err)
+		}
+	}
+}
+
 func testAccCheckSecurityGroupRuleCount(ctx context.Context, group *ec2.SecurityGroup, expectedIngressCount, expectedEgressCount int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		id := aws.StringValue(group.GroupId)
@@ -4721,6 +4825,97 @@ resource "aws_security_group" "test" {
 `, rName)
 }
 
+func testAccVPCSecurityGroupConfig_exclusiveRulesBlocks(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name            = %[1]q
+  vpc_id          = aws_vpc.test.id
+  exclusive_rules = true
+
+  ingress {
+    cidr_blocks = [aws_vpc.test.cidr_block]
+    from_port   = 80
+    protocol    = "tcp"
+    to_port     = 80
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCSecurityGroupConfig_exclusiveRulesSeparateRules(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name            = %[1]q
+  vpc_id          = aws_vpc.test.id
+  exclusive_rules = true
+
+  ingress {
+    cidr_blocks = [aws_vpc.test.cidr_block]
+    from_port   = 80
+    protocol    = "tcp"
+    to_port     = 80
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 8080
+  ip_protocol = "tcp"
+  to_port     = 8080
+
+  mark_managed = true
+}
+`, rName)
+}
+
+func testAccVPCSecurityGroupConfig_exclusiveRulesNoBlocks(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name            = %[1]q
+  vpc_id          = aws_vpc.test.id
+  exclusive_rules = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
 // testAccVPCSecurityGroupConfig_emrLinkedRulesDestroy is very involved but captures
 // a problem seen in EMR and other contexts.
 func testAccVPCSecurityGroupConfig_emrLinkedRulesDestroy(rName string) string {
@@ -0,0 +1,120 @@
+package ec2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
+)
+
+// @FrameworkDataSource(name="Security Group Unmanaged Rules")
+func newDataSourceSecurityGroupUnmanagedRules(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceSecurityGroupUnmanagedRules{}, nil
+}
+
+type dataSourceSecurityGroupUnmanagedRules struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceSecurityGroupUnmanagedRules) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_unmanaged_rules"
+}
+
+func (d *dataSourceSecurityGroupUnmanagedRules) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"security_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"unmanaged_rule_ids": schema.MapAttribute{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceSecurityGroupUnmanagedRules) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceSecurityGroupUnmanagedRulesData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Conn(ctx)
+
+	securityGroupIDs := flex.ExpandFrameworkStringValueSet(ctx, data.SecurityGroupIDs)
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("group-id"),
+				Values: aws.StringSlice(securityGroupIDs),
+			},
+		},
+	}
+
+	output, err := FindSecurityGroupRules(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Security Group Rules", err.Error())
+
+		return
+	}
+
+	// Every requested security group is reported, even those without unmanaged rules.
+	unmanagedRuleIDsByGroup := make(map[string][]string, len(securityGroupIDs))
+	for _, v := range securityGroupIDs {
+		unmanagedRuleIDsByGroup[v] = []string{}
+	}
+
+	var unmanagedRuleIDs []string
+	for _, v := range output {
+		// Rules are owned by a Terraform resource when marked, see mark_managed and exclusive_rules.
+		if securityGroupRuleIsManaged(v) {
+			continue
+		}
+
+		ruleID := aws.StringValue(v.SecurityGroupRuleId)
+		groupID := aws.StringValue(v.GroupId)
+		unmanagedRuleIDsByGroup[groupID] = append(unmanagedRuleIDsByGroup[groupID], ruleID)
+		unmanagedRuleIDs = append(unmanagedRuleIDs, ruleID)
+	}
+
+	unmanagedRules, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, unmanagedRuleIDsByGroup)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	slices.Sort(securityGroupIDs)
+	data.ID = types.StringValue(strings.Join(securityGroupIDs, ","))
+	data.IDs = flex.FlattenFrameworkStringValueListLegacy(ctx, unmanagedRuleIDs)
+	data.UnmanagedRuleIDs = unmanagedRules
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceSecurityGroupUnmanagedRulesData struct {
+	ID               types.String `tfsdk:"id"`
+	IDs              types.List   `tfsdk:"ids"`
+	SecurityGroupIDs types.Set    `tfsdk:"security_group_ids"`
+	UnmanagedRuleIDs types.Map    `tfsdk:"unmanaged_rule_ids"`
+}
@@ -0,0 +1,78 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCSecurityGroupUnmanagedRulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group ec2.SecurityGroup
+	dataSourceName := "data.aws_vpc_security_group_unmanaged_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupUnmanagedRulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, "aws_security_group.test", &group),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "unmanaged_rule_ids.%", "1"),
+				),
+			},
+			// A rule added outside of Terraform is reported.
+			{
+				PreConfig: testAccAuthorizeSecurityGroupIngress(ctx, t, &group, 443),
+				Config:    testAccVPCSecurityGroupUnmanagedRulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "unmanaged_rule_ids.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCSecurityGroupUnmanagedRulesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+
+  mark_managed = true
+}
+
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+
+  mark_managed = true
+}
+
+data "aws_vpc_security_group_unmanaged_rules" "test" {
+  security_group_ids = [aws_security_group.test.id]
+
+  depends_on = [
+    aws_vpc_security_group_ingress_rule.test,
+    aws_vpc_security_group_egress_rule.test,
+  ]
+}
+`)
+}
@@ -118,6 +118,60 @@ func WaitCapacityReservationDeleted(ctx context.Context, conn *ec2.EC2, id strin
 	return nil, err
 }
 
+func WaitCapacityBlockReservationCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.CapacityReservationStatePaymentPending),
+		Target:  enum.Slice(types.CapacityReservationStateActive, types.CapacityReservationStateScheduled),
+		Refresh: StatusCapacityBlockReservationState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetActive(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateSubmitted, ec2.CapacityReservationFleetStateModifying},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled, ec2.CapacityReservationFleetStateCancelling},
+		Target:  []string{},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	CarrierGatewayAvailableTimeout = 5 * time.Minute
 
@@ -3240,3 +3294,415 @@ func WaitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Cli
 
 	return nil, err
 }
+
+func WaitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.VerifiedAccessEndpointStatusCodePending),
+		Target:                    enum.Slice(types.VerifiedAccessEndpointStatusCodeActive),
+		Refresh:                   StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.VerifiedAccessEndpointStatusCodeUpdating),
+		Target:                    enum.Slice(types.VerifiedAccessEndpointStatusCodeActive),
+		Refresh:                   StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VerifiedAccessEndpointStatusCodeDeleting, types.VerifiedAccessEndpointStatusCodeActive),
+		Target:  []string{},
+		Refresh: StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessOptionsUpdated(ctx context.Context, conn *ec2_sdkv2.Client, timeout time.Duration) (*types.VpcBlockPublicAccessOptions, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.VpcBlockPublicAccessStateUpdateInProgress),
+		Target:                    enum.Slice(types.VpcBlockPublicAccessStateUpdateComplete),
+		Refresh:                   StatusVPCBlockPublicAccessOptions(ctx, conn),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessOptions); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessExclusionCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateCreateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessExclusionStateCreateComplete),
+		Refresh: StatusVPCBlockPublicAccessExclusion(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessExclusionUpdated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateUpdateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessExclusionStateUpdateComplete),
+		Refresh: StatusVPCBlockPublicAccessExclusion(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessExclusionDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateDeleteInProgress),
+		Target:  []string{},
+		Refresh: StatusVPCBlockPublicAccessExclusion(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitImageBlockPublicAccessStateUpdated(ctx context.Context, conn *ec2_sdkv2.Client, target string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   imageBlockPublicAccessState_Values(),
+		Target:                    []string{target},
+		Refresh:                   StatusImageBlockPublicAccessState(ctx, conn),
+		Timeout:                   timeout,
+		Delay:                     10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func WaitDefaultCreditSpecificationUpdated(ctx context.Context, conn *ec2_sdkv2.Client, instanceFamily, cpuCredits string, timeout time.Duration) (*types.InstanceFamilyCreditSpecification, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   CPUCredits_Values(),
+		Target:                    []string{cpuCredits},
+		Refresh:                   StatusDefaultCreditSpecification(ctx, conn, instanceFamily),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.InstanceFamilyCreditSpecification); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.RouteServer, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RouteServerStatePending),
+		Target:  enum.Slice(types.RouteServerStateAvailable),
+		Refresh: StatusRouteServerState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerUpdated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.RouteServer, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.RouteServerStateModifying),
+		Target:                    enum.Slice(types.RouteServerStateAvailable),
+		Refresh:                   StatusRouteServerState(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.RouteServer, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RouteServerStateAvailable, types.RouteServerStateModifying, types.RouteServerStateDeleting),
+		Target:  []string{},
+		Refresh: StatusRouteServerState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerEndpointCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.RouteServerEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RouteServerEndpointStatePending),
+		Target:  enum.Slice(types.RouteServerEndpointStateAvailable),
+		Refresh: StatusRouteServerEndpointState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServerEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.RouteServerEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RouteServerEndpointStateAvailable, types.RouteServerEndpointStateDeleting),
+		Target:  []string{},
+		Refresh: StatusRouteServerEndpointState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServerEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerPeerCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.RouteServerPeer, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RouteServerPeerStatePending),
+		Target:  enum.Slice(types.RouteServerPeerStateAvailable),
+		Refresh: StatusRouteServerPeerState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServerPeer); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerPeerDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.RouteServerPeer, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RouteServerPeerStateAvailable, types.RouteServerPeerStateDeleting),
+		Target:  []string{},
+		Refresh: StatusRouteServerPeerState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServerPeer); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerVPCAssociationCreated(ctx context.Context, conn *ec2_sdkv2.Client, routeServerID, vpcID string, timeout time.Duration) (*types.RouteServerAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RouteServerAssociationStateAssociating),
+		Target:  enum.Slice(types.RouteServerAssociationStateAssociated),
+		Refresh: StatusRouteServerVPCAssociationState(ctx, conn, routeServerID, vpcID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServerAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerVPCAssociationDeleted(ctx context.Context, conn *ec2_sdkv2.Client, routeServerID, vpcID string, timeout time.Duration) (*types.RouteServerAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RouteServerAssociationStateAssociated, types.RouteServerAssociationStateDisassociating),
+		Target:  []string{},
+		Refresh: StatusRouteServerVPCAssociationState(ctx, conn, routeServerID, vpcID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServerAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerPropagationCreated(ctx context.Context, conn *ec2_sdkv2.Client, routeServerID, routeTableID string, timeout time.Duration) (*types.RouteServerPropagation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RouteServerPropagationStatePending),
+		Target:  enum.Slice(types.RouteServerPropagationStateAvailable),
+		Refresh: StatusRouteServerPropagationState(ctx, conn, routeServerID, routeTableID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServerPropagation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitRouteServerPropagationDeleted(ctx context.Context, conn *ec2_sdkv2.Client, routeServerID, routeTableID string, timeout time.Duration) (*types.RouteServerPropagation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RouteServerPropagationStateAvailable, types.RouteServerPropagationStateDeleting),
+		Target:  []string{},
+		Refresh: StatusRouteServerPropagationState(ctx, conn, routeServerID, routeTableID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RouteServerPropagation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFastSnapshotRestoreCreated(ctx context.Context, conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{ec2.FastSnapshotRestoreStateCodeEnabled},
+		Refresh: StatusFastSnapshotRestoreState(ctx, conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFastSnapshotRestoreDeleted(ctx context.Context, conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing, ec2.FastSnapshotRestoreStateCodeEnabled, ec2.FastSnapshotRestoreStateCodeDisabling},
+		Target:  []string{},
+		Refresh: StatusFastSnapshotRestoreState(ctx, conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -2,7 +2,9 @@ package eks
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,6 +22,19 @@ func DataSourceAddonVersion() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"check_addon_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"compatible": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"compatible_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"kubernetes_version": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -37,6 +52,7 @@ func DataSourceAddonVersion() *schema.Resource {
 }
 
 func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EKSConn(ctx)
 
 	addonName := d.Get("addon_name").(string)
@@ -50,12 +66,41 @@ func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("reading EKS Add-On version info (%s, %s): %s", id, kubernetesVersion, err)
 	}
 
+	compatibleVersions, err := findAddonVersionsByAddonNameAndKubernetesVersion(ctx, conn, id, kubernetesVersion)
+
+	if err != nil {
+		return diag.Errorf("reading EKS Add-On versions (%s, %s): %s", id, kubernetesVersion, err)
+	}
+
+	// Report an installed or planned add-on version that the target Kubernetes
+	// version does not support while the configuration is still being planned.
+	compatible := true
+	if v, ok := d.GetOk("check_addon_version"); ok {
+		compatible = false
+		for _, compatibleVersion := range compatibleVersions {
+			if compatibleVersion == v.(string) {
+				compatible = true
+				break
+			}
+		}
+
+		if !compatible {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "EKS Add-On version is not compatible with Kubernetes version",
+				Detail:   fmt.Sprintf("EKS Add-On %s version %s is not compatible with Kubernetes version %s. Upgrade the add-on to a compatible version (for example %s) before or together with the cluster version upgrade.", id, v.(string), kubernetesVersion, aws.StringValue(versionInfo.AddonVersion)),
+			})
+		}
+	}
+
 	d.SetId(id)
 
 	d.Set("addon_name", addonName)
+	d.Set("compatible", compatible)
+	d.Set("compatible_versions", compatibleVersions)
 	d.Set("kubernetes_version", kubernetesVersion)
 	d.Set("most_recent", mostRecent)
 	d.Set("version", versionInfo.AddonVersion)
 
-	return nil
+	return diags
 }
@@ -46,6 +46,31 @@ func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccEKSAddonVersionDataSource_checkAddonVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	compatibleDataSourceName := "data.aws_eks_addon_version.compatible"
+	incompatibleDataSourceName := "data.aws_eks_addon_version.incompatible"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonVersionDataSourceConfig_checkAddonVersion(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(compatibleDataSourceName, "compatible", "true"),
+					resource.TestCheckResourceAttrSet(compatibleDataSourceName, "compatible_versions.#"),
+					resource.TestCheckResourceAttr(incompatibleDataSourceName, "compatible", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAddonVersionDataSourceConfig_basic(rName, addonName string, mostRecent bool) string {
 	return acctest.ConfigCompose(testAccAddonConfig_base(rName), fmt.Sprintf(`
 data "aws_eks_addon_version" "test" {
@@ -74,3 +99,24 @@ data "aws_eks_addon" "test" {
 }
 `, rName, addonName, mostRecent))
 }
+
+func testAccAddonVersionDataSourceConfig_checkAddonVersion(rName, addonName string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName), fmt.Sprintf(`
+data "aws_eks_addon_version" "default" {
+  addon_name         = %[1]q
+  kubernetes_version = aws_eks_cluster.test.version
+}
+
+data "aws_eks_addon_version" "compatible" {
+  addon_name          = %[1]q
+  kubernetes_version  = aws_eks_cluster.test.version
+  check_addon_version = data.aws_eks_addon_version.default.version
+}
+
+data "aws_eks_addon_version" "incompatible" {
+  addon_name          = %[1]q
+  kubernetes_version  = aws_eks_cluster.test.version
+  check_addon_version = "v0.0.1-eksbuild.1"
+}
+`, addonName))
+}
@@ -6,15 +6,18 @@ import (
 	"regexp"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -50,6 +53,28 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"access_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AuthenticationMode](),
+						},
+						"bootstrap_cluster_creator_admin_permissions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -79,7 +104,7 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(eks.LogType_Values(), true),
+					ValidateFunc: validation.StringInSlice(enum.Values[awstypes.LogType](), true),
 				},
 				Set: schema.HashString,
 			},
@@ -147,11 +172,11 @@ func ResourceCluster() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"ip_family": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.StringInSlice(eks.IpFamily_Values(), false),
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.IpFamily](),
 						},
 						"service_ipv4_cidr": {
 							Type:     schema.TypeString,
@@ -229,6 +254,22 @@ func ResourceCluster() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upgrade_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"support_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.SupportType](),
+						},
+					},
+				},
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -284,12 +325,26 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"zonal_shift_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
 
 	name := d.Get("name").(string)
 	input := &eks.CreateClusterInput{
@@ -298,7 +353,18 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		Name:               aws.String(name),
 		ResourcesVpcConfig: expandVPCConfigRequestForCreate(d.Get("vpc_config").([]interface{})),
 		RoleArn:            aws.String(d.Get("role_arn").(string)),
-		Tags:               getTagsIn(ctx),
+		Tags:               getTagsInV2(ctx),
+	}
+
+	if v, ok := d.GetOk("access_config"); ok {
+		input.AccessConfig = expandCreateAccessConfigRequest(v.([]interface{}))
+
+		// The bootstrap setting is Computed, so it is only sent when configured.
+		if v := d.GetRawConfig().GetAttr("access_config"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			if v := v.Index(cty.NumberIntVal(0)).GetAttr("bootstrap_cluster_creator_admin_permissions"); v.IsKnown() && !v.IsNull() {
+				input.AccessConfig.BootstrapClusterCreatorAdminPermissions = aws.Bool(v.True())
+			}
+		}
 	}
 
 	if _, ok := d.GetOk("kubernetes_network_config"); ok {
@@ -309,36 +375,44 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.OutpostConfig = expandOutpostConfigRequest(d.Get("outpost_config").([]interface{}))
 	}
 
+	if v, ok := d.GetOk("upgrade_policy"); ok {
+		input.UpgradePolicy = expandUpgradePolicyRequest(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("version"); ok {
 		input.Version = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("zonal_shift_config"); ok {
+		input.ZonalShiftConfig = expandZonalShiftConfigRequest(v.([]interface{}))
+	}
+
 	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
 		func() (interface{}, error) {
-			return conn.CreateClusterWithContext(ctx, input)
+			return conn.CreateCluster(ctx, input)
 		},
 		func(err error) (bool, error) {
 			// InvalidParameterException: roleArn, arn:aws:iam::123456789012:role/XXX, does not exist
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "does not exist") {
+			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterException](err, "does not exist") {
 				return true, err
 			}
 
 			// InvalidParameterException: Error in role params
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "Error in role params") {
+			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterException](err, "Error in role params") {
 				return true, err
 			}
 
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "Role could not be assumed because the trusted entity is not correct") {
+			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterException](err, "Role could not be assumed because the trusted entity is not correct") {
 				return true, err
 			}
 
 			// InvalidParameterException: The provided role doesn't have the Amazon EKS Managed Policies associated with it. Please ensure the following policy is attached: arn:aws:iam::aws:policy/AmazonEKSClusterPolicy
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "The provided role doesn't have the Amazon EKS Managed Policies associated with it") {
+			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterException](err, "The provided role doesn't have the Amazon EKS Managed Policies associated with it") {
 				return true, err
 			}
 
 			// InvalidParameterException: IAM role's policy must include the `ec2:DescribeSubnets` action
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "IAM role's policy must include") {
+			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterException](err, "IAM role's policy must include") {
 				return true, err
 			}
 
@@ -350,7 +424,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("creating EKS Cluster (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(outputRaw.(*eks.CreateClusterOutput).Cluster.Name))
+	d.SetId(aws.ToString(outputRaw.(*eks.CreateClusterOutput).Cluster.Name))
 
 	if _, err := waitClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for EKS Cluster (%s) create: %s", d.Id(), err)
@@ -360,7 +434,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
 
 	cluster, err := FindClusterByName(ctx, conn, d.Id())
 
@@ -374,6 +448,9 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("reading EKS Cluster (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("access_config", flattenAccessConfigResponse(cluster.AccessConfig, d.Get("access_config.0.bootstrap_cluster_creator_admin_permissions").(bool))); err != nil {
+		return diag.Errorf("setting access_config: %s", err)
+	}
 	d.Set("arn", cluster.Arn)
 	if err := d.Set("certificate_authority", flattenCertificate(cluster.CertificateAuthority)); err != nil {
 		return diag.Errorf("setting certificate_authority: %s", err)
@@ -382,7 +459,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if cluster.OutpostConfig != nil {
 		d.Set("cluster_id", cluster.Id)
 	}
-	d.Set("created_at", aws.TimeValue(cluster.CreatedAt).String())
+	d.Set("created_at", aws.ToTime(cluster.CreatedAt).String())
 	if err := d.Set("enabled_cluster_log_types", flattenLogging(cluster.Logging)); err != nil {
 		return diag.Errorf("setting enabled_cluster_log_types: %s", err)
 	}
@@ -403,18 +480,24 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("platform_version", cluster.PlatformVersion)
 	d.Set("role_arn", cluster.RoleArn)
 	d.Set("status", cluster.Status)
+	if err := d.Set("upgrade_policy", flattenUpgradePolicyResponse(cluster.UpgradePolicy)); err != nil {
+		return diag.Errorf("setting upgrade_policy: %s", err)
+	}
 	d.Set("version", cluster.Version)
 	if err := d.Set("vpc_config", flattenVPCConfigResponse(cluster.ResourcesVpcConfig)); err != nil {
 		return diag.Errorf("setting vpc_config: %s", err)
 	}
+	if err := d.Set("zonal_shift_config", flattenZonalShiftConfigResponse(cluster.ZonalShiftConfig)); err != nil {
+		return diag.Errorf("setting zonal_shift_config: %s", err)
+	}
 
-	setTagsOut(ctx, cluster.Tags)
+	setTagsOutV2(ctx, cluster.Tags)
 
 	return nil
 }
 
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
 
 	// Do any version update first.
 	if d.HasChange("version") {
@@ -423,13 +506,13 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			Version: aws.String(d.Get("version").(string)),
 		}
 
-		output, err := conn.UpdateClusterVersionWithContext(ctx, input)
+		output, err := conn.UpdateClusterVersion(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating EKS Cluster (%s) version: %s", d.Id(), err)
 		}
 
-		updateID := aws.StringValue(output.Update.Id)
+		updateID := aws.ToString(output.Update.Id)
 
 		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
@@ -447,13 +530,13 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 				EncryptionConfig: expandEncryptionConfig(d.Get("encryption_config").([]interface{})),
 			}
 
-			output, err := conn.AssociateEncryptionConfigWithContext(ctx, input)
+			output, err := conn.AssociateEncryptionConfig(ctx, input)
 
 			if err != nil {
 				return diag.Errorf("associating EKS Cluster (%s) encryption config: %s", d.Id(), err)
 			}
 
-			updateID := aws.StringValue(output.Update.Id)
+			updateID := aws.ToString(output.Update.Id)
 
 			_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
@@ -463,19 +546,42 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("access_config.0.authentication_mode") {
+		input := &eks.UpdateClusterConfigInput{
+			AccessConfig: &awstypes.UpdateAccessConfigRequest{
+				AuthenticationMode: awstypes.AuthenticationMode(d.Get("access_config.0.authentication_mode").(string)),
+			},
+			Name: aws.String(d.Id()),
+		}
+
+		output, err := conn.UpdateClusterConfig(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Cluster (%s) access config: %s", d.Id(), err)
+		}
+
+		updateID := aws.ToString(output.Update.Id)
+
+		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("waiting for EKS Cluster (%s) access config update (%s): %s", d.Id(), updateID, err)
+		}
+	}
+
 	if d.HasChange("enabled_cluster_log_types") {
 		input := &eks.UpdateClusterConfigInput{
 			Logging: expandLogging(d.Get("enabled_cluster_log_types").(*schema.Set)),
 			Name:    aws.String(d.Id()),
 		}
 
-		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
+		output, err := conn.UpdateClusterConfig(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating EKS Cluster (%s) logging: %s", d.Id(), err)
 		}
 
-		updateID := aws.StringValue(output.Update.Id)
+		updateID := aws.ToString(output.Update.Id)
 
 		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
@@ -490,13 +596,13 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			ResourcesVpcConfig: expandVPCConfigRequestForUpdate(d.Get("vpc_config").([]interface{})),
 		}
 
-		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
+		output, err := conn.UpdateClusterConfig(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating EKS Cluster (%s) VPC config: %s", d.Id(), err)
 		}
 
-		updateID := aws.StringValue(output.Update.Id)
+		updateID := aws.ToString(output.Update.Id)
 
 		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
@@ -505,11 +611,55 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("upgrade_policy") {
+		input := &eks.UpdateClusterConfigInput{
+			Name:          aws.String(d.Id()),
+			UpgradePolicy: expandUpgradePolicyRequest(d.Get("upgrade_policy").([]interface{})),
+		}
+
+		output, err := conn.UpdateClusterConfig(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Cluster (%s) upgrade policy: %s", d.Id(), err)
+		}
+
+		updateID := aws.ToString(output.Update.Id)
+
+		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("waiting for EKS Cluster (%s) upgrade policy update (%s): %s", d.Id(), updateID, err)
+		}
+	}
+
+	if d.HasChange("zonal_shift_config") {
+		input := &eks.UpdateClusterConfigInput{
+			Name: aws.String(d.Id()),
+			ZonalShiftConfig: &awstypes.ZonalShiftConfigRequest{
+				Enabled: aws.Bool(d.Get("zonal_shift_config.0.enabled").(bool)),
+			},
+		}
+
+		output, err := conn.UpdateClusterConfig(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Cluster (%s) zonal shift config: %s", d.Id(), err)
+		}
+
+		updateID := aws.ToString(output.Update.Id)
+
+		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("waiting for EKS Cluster (%s) zonal shift config update (%s): %s", d.Id(), updateID, err)
+		}
+	}
+
 	return resourceClusterRead(ctx, d, meta)
 }
 
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
 
 	log.Printf("[DEBUG] Deleting EKS Cluster: %s", d.Id())
 
@@ -522,9 +672,9 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	err := tfresource.Retry(ctx, clusterDeleteRetryTimeout, func() *retry.RetryError {
 		var err error
 
-		_, err = conn.DeleteClusterWithContext(ctx, input)
+		_, err = conn.DeleteCluster(ctx, input)
 
-		if tfawserr.ErrMessageContains(err, eks.ErrCodeResourceInUseException, "in progress") {
+		if errs.IsAErrorMessageContains[*awstypes.ResourceInUseException](err, "in progress") {
 			return retry.RetryableError(err)
 		}
 
@@ -536,16 +686,16 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	}, tfresource.WithDelayRand(1*time.Minute), tfresource.WithPollInterval(30*time.Second))
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.DeleteClusterWithContext(ctx, input)
+		_, err = conn.DeleteCluster(ctx, input)
 	}
 
-	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil
 	}
 
 	// Sometimes the EKS API returns the ResourceNotFound error in this form:
 	// ClientException: No cluster found for name: tf-acc-test-0o1f8
-	if tfawserr.ErrMessageContains(err, eks.ErrCodeClientException, "No cluster found for name:") {
+	if errs.IsAErrorMessageContains[*awstypes.ClientException](err, "No cluster found for name:") {
 		return nil
 	}
 
@@ -560,16 +710,16 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return nil
 }
 
-func FindClusterByName(ctx context.Context, conn *eks.EKS, name string) (*eks.Cluster, error) {
+func FindClusterByName(ctx context.Context, conn *eks.Client, name string) (*awstypes.Cluster, error) {
 	input := &eks.DescribeClusterInput{
 		Name: aws.String(name),
 	}
 
-	output, err := conn.DescribeClusterWithContext(ctx, input)
+	output, err := conn.DescribeCluster(ctx, input)
 
 	// Sometimes the EKS API returns the ResourceNotFound error in this form:
 	// ClientException: No cluster found for name: tf-acc-test-0o1f8
-	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, eks.ErrCodeClientException, "No cluster found for name:") {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*awstypes.ClientException](err, "No cluster found for name:") {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -587,15 +737,15 @@ func FindClusterByName(ctx context.Context, conn *eks.EKS, name string) (*eks.Cl
 	return output.Cluster, nil
 }
 
-func findClusterUpdateByTwoPartKey(ctx context.Context, conn *eks.EKS, name, id string) (*eks.Update, error) {
+func findClusterUpdateByTwoPartKey(ctx context.Context, conn *eks.Client, name, id string) (*awstypes.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		Name:     aws.String(name),
 		UpdateId: aws.String(id),
 	}
 
-	output, err := conn.DescribeUpdateWithContext(ctx, input)
+	output, err := conn.DescribeUpdate(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -613,7 +763,7 @@ func findClusterUpdateByTwoPartKey(ctx context.Context, conn *eks.EKS, name, id
 	return output.Update, nil
 }
 
-func statusCluster(ctx context.Context, conn *eks.EKS, name string) retry.StateRefreshFunc {
+func statusCluster(ctx context.Context, conn *eks.Client, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindClusterByName(ctx, conn, name)
 
@@ -625,11 +775,11 @@ func statusCluster(ctx context.Context, conn *eks.EKS, name string) retry.StateR
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.Status), nil
+		return output, string(output.Status), nil
 	}
 }
 
-func statusClusterUpdate(ctx context.Context, conn *eks.EKS, name, id string) retry.StateRefreshFunc {
+func statusClusterUpdate(ctx context.Context, conn *eks.Client, name, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findClusterUpdateByTwoPartKey(ctx, conn, name, id)
 
@@ -641,30 +791,30 @@ func statusClusterUpdate(ctx context.Context, conn *eks.EKS, name, id string) re
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.Status), nil
+		return output, string(output.Status), nil
 	}
 }
 
-func waitClusterCreated(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*awstypes.Cluster, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{eks.ClusterStatusPending, eks.ClusterStatusCreating},
-		Target:  []string{eks.ClusterStatusActive},
+		Pending: enum.Slice(awstypes.ClusterStatusPending, awstypes.ClusterStatusCreating),
+		Target:  enum.Slice(awstypes.ClusterStatusActive),
 		Refresh: statusCluster(ctx, conn, name),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*eks.Cluster); ok {
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		return output, err
 	}
 
 	return nil, err
 }
 
-func waitClusterDeleted(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*awstypes.Cluster, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
+		Pending: enum.Slice(awstypes.ClusterStatusActive, awstypes.ClusterStatusDeleting),
 		Target:  []string{},
 		Refresh: statusCluster(ctx, conn, name),
 		Timeout: timeout,
@@ -672,26 +822,26 @@ func waitClusterDeleted(ctx context.Context, conn *eks.EKS, name string, timeout
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*eks.Cluster); ok {
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		return output, err
 	}
 
 	return nil, err
 }
 
-func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.EKS, name, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
+func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id string, timeout time.Duration) (*awstypes.Update, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{eks.UpdateStatusInProgress},
-		Target:  []string{eks.UpdateStatusSuccessful},
+		Pending: enum.Slice(awstypes.UpdateStatusInProgress),
+		Target:  enum.Slice(awstypes.UpdateStatusSuccessful),
 		Refresh: statusClusterUpdate(ctx, conn, name, id),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*eks.Update); ok {
-		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
-			tfresource.SetLastError(err, ErrorDetailsError(output.Errors))
+	if output, ok := outputRaw.(*awstypes.Update); ok {
+		if status := output.Status; status == awstypes.UpdateStatusCancelled || status == awstypes.UpdateStatusFailed {
+			tfresource.SetLastError(err, errorDetailsErrorV2(output.Errors))
 		}
 
 		return output, err
@@ -700,12 +850,32 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.EKS, name, id st
 	return nil, err
 }
 
-func expandEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
+func expandCreateAccessConfigRequest(tfList []interface{}) *awstypes.CreateAccessConfigRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &awstypes.CreateAccessConfigRequest{}
+
+	if v, ok := tfMap["authentication_mode"].(string); ok && v != "" {
+		apiObject.AuthenticationMode = awstypes.AuthenticationMode(v)
+	}
+
+	return apiObject
+}
+
+func expandEncryptionConfig(tfList []interface{}) []awstypes.EncryptionConfig {
 	if len(tfList) == 0 {
 		return nil
 	}
 
-	var apiObjects []*eks.EncryptionConfig
+	var apiObjects []awstypes.EncryptionConfig
 
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -714,12 +884,12 @@ func expandEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
 			continue
 		}
 
-		apiObject := &eks.EncryptionConfig{
+		apiObject := awstypes.EncryptionConfig{
 			Provider: expandProvider(tfMap["provider"].([]interface{})),
 		}
 
 		if v, ok := tfMap["resources"].(*schema.Set); ok && v.Len() > 0 {
-			apiObject.Resources = flex.ExpandStringSet(v)
+			apiObject.Resources = flex.ExpandStringValueSet(v)
 		}
 
 		apiObjects = append(apiObjects, apiObject)
@@ -728,14 +898,14 @@ func expandEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
 	return apiObjects
 }
 
-func expandProvider(tfList []interface{}) *eks.Provider {
+func expandProvider(tfList []interface{}) *awstypes.Provider {
 	tfMap, ok := tfList[0].(map[string]interface{})
 
 	if !ok {
 		return nil
 	}
 
-	apiObject := &eks.Provider{}
+	apiObject := &awstypes.Provider{}
 
 	if v, ok := tfMap["key_arn"].(string); ok && v != "" {
 		apiObject.KeyArn = aws.String(v)
@@ -744,14 +914,14 @@ func expandProvider(tfList []interface{}) *eks.Provider {
 	return apiObject
 }
 
-func expandOutpostConfigRequest(l []interface{}) *eks.OutpostConfigRequest {
+func expandOutpostConfigRequest(l []interface{}) *awstypes.OutpostConfigRequest {
 	tfMap, ok := l[0].(map[string]interface{})
 
 	if !ok {
 		return nil
 	}
 
-	outpostConfigRequest := &eks.OutpostConfigRequest{}
+	outpostConfigRequest := &awstypes.OutpostConfigRequest{}
 
 	if v, ok := tfMap["control_plane_instance_type"].(string); ok && v != "" {
 		outpostConfigRequest.ControlPlaneInstanceType = aws.String(v)
@@ -762,13 +932,13 @@ func expandOutpostConfigRequest(l []interface{}) *eks.OutpostConfigRequest {
 	}
 
 	if v, ok := tfMap["outpost_arns"].(*schema.Set); ok && v.Len() > 0 {
-		outpostConfigRequest.OutpostArns = flex.ExpandStringSet(v)
+		outpostConfigRequest.OutpostArns = flex.ExpandStringValueSet(v)
 	}
 
 	return outpostConfigRequest
 }
 
-func expandControlPlanePlacement(tfList []interface{}) *eks.ControlPlanePlacementRequest {
+func expandControlPlanePlacement(tfList []interface{}) *awstypes.ControlPlanePlacementRequest {
 	if len(tfList) == 0 {
 		return nil
 	}
@@ -779,7 +949,7 @@ func expandControlPlanePlacement(tfList []interface{}) *eks.ControlPlanePlacemen
 		return nil
 	}
 
-	apiObject := &eks.ControlPlanePlacementRequest{}
+	apiObject := &awstypes.ControlPlanePlacementRequest{}
 
 	if v, ok := tfMap["group_name"].(string); ok && v != "" {
 		apiObject.GroupName = aws.String(v)
@@ -788,100 +958,182 @@ func expandControlPlanePlacement(tfList []interface{}) *eks.ControlPlanePlacemen
 	return apiObject
 }
 
-func expandVPCConfigRequestForCreate(l []interface{}) *eks.VpcConfigRequest {
+func expandVPCConfigRequestForCreate(l []interface{}) *awstypes.VpcConfigRequest {
 	if len(l) == 0 {
 		return nil
 	}
 
 	m := l[0].(map[string]interface{})
 
-	vpcConfigRequest := &eks.VpcConfigRequest{
+	vpcConfigRequest := &awstypes.VpcConfigRequest{
 		EndpointPrivateAccess: aws.Bool(m["endpoint_private_access"].(bool)),
 		EndpointPublicAccess:  aws.Bool(m["endpoint_public_access"].(bool)),
-		SecurityGroupIds:      flex.ExpandStringSet(m["security_group_ids"].(*schema.Set)),
-		SubnetIds:             flex.ExpandStringSet(m["subnet_ids"].(*schema.Set)),
+		SecurityGroupIds:      flex.ExpandStringValueSet(m["security_group_ids"].(*schema.Set)),
+		SubnetIds:             flex.ExpandStringValueSet(m["subnet_ids"].(*schema.Set)),
 	}
 
 	if v, ok := m["public_access_cidrs"].(*schema.Set); ok && v.Len() > 0 {
-		vpcConfigRequest.PublicAccessCidrs = flex.ExpandStringSet(v)
+		vpcConfigRequest.PublicAccessCidrs = flex.ExpandStringValueSet(v)
 	}
 
 	return vpcConfigRequest
 }
 
-func expandVPCConfigRequestForUpdate(l []interface{}) *eks.VpcConfigRequest {
+func expandVPCConfigRequestForUpdate(l []interface{}) *awstypes.VpcConfigRequest {
 	if len(l) == 0 {
 		return nil
 	}
 
 	m := l[0].(map[string]interface{})
 
-	vpcConfigRequest := &eks.VpcConfigRequest{
+	vpcConfigRequest := &awstypes.VpcConfigRequest{
 		EndpointPrivateAccess: aws.Bool(m["endpoint_private_access"].(bool)),
 		EndpointPublicAccess:  aws.Bool(m["endpoint_public_access"].(bool)),
 	}
 
 	if v, ok := m["public_access_cidrs"].(*schema.Set); ok && v.Len() > 0 {
-		vpcConfigRequest.PublicAccessCidrs = flex.ExpandStringSet(v)
+		vpcConfigRequest.PublicAccessCidrs = flex.ExpandStringValueSet(v)
 	}
 
 	return vpcConfigRequest
 }
 
-func expandKubernetesNetworkConfigRequest(tfList []interface{}) *eks.KubernetesNetworkConfigRequest {
+func expandKubernetesNetworkConfigRequest(tfList []interface{}) *awstypes.KubernetesNetworkConfigRequest {
 	tfMap, ok := tfList[0].(map[string]interface{})
 
 	if !ok {
 		return nil
 	}
 
-	apiObject := &eks.KubernetesNetworkConfigRequest{}
+	apiObject := &awstypes.KubernetesNetworkConfigRequest{}
 
 	if v, ok := tfMap["service_ipv4_cidr"].(string); ok && v != "" {
 		apiObject.ServiceIpv4Cidr = aws.String(v)
 	}
 
 	if v, ok := tfMap["ip_family"].(string); ok && v != "" {
-		apiObject.IpFamily = aws.String(v)
+		apiObject.IpFamily = awstypes.IpFamily(v)
 	}
 
 	return apiObject
 }
 
-func expandLogging(vEnabledLogTypes *schema.Set) *eks.Logging {
+func expandLogging(vEnabledLogTypes *schema.Set) *awstypes.Logging {
 	vEksLogTypes := []interface{}{}
-	for _, eksLogType := range eks.LogType_Values() {
+	for _, eksLogType := range enum.Values[awstypes.LogType]() {
 		vEksLogTypes = append(vEksLogTypes, eksLogType)
 	}
 	vAllLogTypes := schema.NewSet(schema.HashString, vEksLogTypes)
 
-	return &eks.Logging{
-		ClusterLogging: []*eks.LogSetup{
+	return &awstypes.Logging{
+		ClusterLogging: []awstypes.LogSetup{
 			{
 				Enabled: aws.Bool(true),
-				Types:   flex.ExpandStringSet(vEnabledLogTypes),
+				Types:   flex.ExpandStringyValueSet[awstypes.LogType](vEnabledLogTypes),
 			},
 			{
 				Enabled: aws.Bool(false),
-				Types:   flex.ExpandStringSet(vAllLogTypes.Difference(vEnabledLogTypes)),
+				Types:   flex.ExpandStringyValueSet[awstypes.LogType](vAllLogTypes.Difference(vEnabledLogTypes)),
 			},
 		},
 	}
 }
 
-func flattenCertificate(certificate *eks.Certificate) []map[string]interface{} {
+func flattenAccessConfigResponse(apiObject *awstypes.AccessConfigResponse, bootstrapClusterCreatorAdminPermissions bool) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"authentication_mode":                         string(apiObject.AuthenticationMode),
+		"bootstrap_cluster_creator_admin_permissions": bootstrapClusterCreatorAdminPermissions,
+	}
+
+	// The API only returns the bootstrap setting for clusters created with it.
+	if v := apiObject.BootstrapClusterCreatorAdminPermissions; v != nil {
+		tfMap["bootstrap_cluster_creator_admin_permissions"] = aws.ToBool(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandUpgradePolicyRequest(tfList []interface{}) *awstypes.UpgradePolicyRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &awstypes.UpgradePolicyRequest{}
+
+	if v, ok := tfMap["support_type"].(string); ok && v != "" {
+		apiObject.SupportType = awstypes.SupportType(v)
+	}
+
+	return apiObject
+}
+
+func expandZonalShiftConfigRequest(tfList []interface{}) *awstypes.ZonalShiftConfigRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &awstypes.ZonalShiftConfigRequest{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenUpgradePolicyResponse(apiObject *awstypes.UpgradePolicyResponse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"support_type": string(apiObject.SupportType),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenZonalShiftConfigResponse(apiObject *awstypes.ZonalShiftConfigResponse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled": aws.ToBool(apiObject.Enabled),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCertificate(certificate *awstypes.Certificate) []map[string]interface{} {
 	if certificate == nil {
 		return []map[string]interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"data": aws.StringValue(certificate.Data),
+		"data": aws.ToString(certificate.Data),
 	}
 
 	return []map[string]interface{}{m}
 }
 
-func flattenIdentity(identity *eks.Identity) []map[string]interface{} {
+func flattenIdentity(identity *awstypes.Identity) []map[string]interface{} {
 	if identity == nil {
 		return []map[string]interface{}{}
 	}
@@ -893,19 +1145,19 @@ func flattenIdentity(identity *eks.Identity) []map[string]interface{} {
 	return []map[string]interface{}{m}
 }
 
-func flattenOIDC(oidc *eks.OIDC) []map[string]interface{} {
+func flattenOIDC(oidc *awstypes.OIDC) []map[string]interface{} {
 	if oidc == nil {
 		return []map[string]interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"issuer": aws.StringValue(oidc.Issuer),
+		"issuer": aws.ToString(oidc.Issuer),
 	}
 
 	return []map[string]interface{}{m}
 }
 
-func flattenEncryptionConfig(apiObjects []*eks.EncryptionConfig) []interface{} {
+func flattenEncryptionConfig(apiObjects []awstypes.EncryptionConfig) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -915,7 +1167,7 @@ func flattenEncryptionConfig(apiObjects []*eks.EncryptionConfig) []interface{} {
 	for _, apiObject := range apiObjects {
 		tfMap := map[string]interface{}{
 			"provider":  flattenProvider(apiObject.Provider),
-			"resources": aws.StringValueSlice(apiObject.Resources),
+			"resources": apiObject.Resources,
 		}
 
 		tfList = append(tfList, tfMap)
@@ -924,88 +1176,88 @@ func flattenEncryptionConfig(apiObjects []*eks.EncryptionConfig) []interface{} {
 	return tfList
 }
 
-func flattenProvider(apiObject *eks.Provider) []interface{} {
+func flattenProvider(apiObject *awstypes.Provider) []interface{} {
 	if apiObject == nil {
 		return nil
 	}
 
 	tfMap := map[string]interface{}{
-		"key_arn": aws.StringValue(apiObject.KeyArn),
+		"key_arn": aws.ToString(apiObject.KeyArn),
 	}
 
 	return []interface{}{tfMap}
 }
 
-func flattenVPCConfigResponse(vpcConfig *eks.VpcConfigResponse) []map[string]interface{} {
+func flattenVPCConfigResponse(vpcConfig *awstypes.VpcConfigResponse) []map[string]interface{} {
 	if vpcConfig == nil {
 		return []map[string]interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"cluster_security_group_id": aws.StringValue(vpcConfig.ClusterSecurityGroupId),
-		"endpoint_private_access":   aws.BoolValue(vpcConfig.EndpointPrivateAccess),
-		"endpoint_public_access":    aws.BoolValue(vpcConfig.EndpointPublicAccess),
-		"security_group_ids":        flex.FlattenStringSet(vpcConfig.SecurityGroupIds),
-		"subnet_ids":                flex.FlattenStringSet(vpcConfig.SubnetIds),
-		"public_access_cidrs":       flex.FlattenStringSet(vpcConfig.PublicAccessCidrs),
-		"vpc_id":                    aws.StringValue(vpcConfig.VpcId),
+		"cluster_security_group_id": aws.ToString(vpcConfig.ClusterSecurityGroupId),
+		"endpoint_private_access":   vpcConfig.EndpointPrivateAccess,
+		"endpoint_public_access":    vpcConfig.EndpointPublicAccess,
+		"security_group_ids":        flex.FlattenStringValueSet(vpcConfig.SecurityGroupIds),
+		"subnet_ids":                flex.FlattenStringValueSet(vpcConfig.SubnetIds),
+		"public_access_cidrs":       flex.FlattenStringValueSet(vpcConfig.PublicAccessCidrs),
+		"vpc_id":                    aws.ToString(vpcConfig.VpcId),
 	}
 
 	return []map[string]interface{}{m}
 }
 
-func flattenLogging(logging *eks.Logging) *schema.Set {
-	enabledLogTypes := []*string{}
+func flattenLogging(logging *awstypes.Logging) *schema.Set {
+	enabledLogTypes := []string{}
 
 	if logging != nil {
 		logSetups := logging.ClusterLogging
 		for _, logSetup := range logSetups {
-			if logSetup == nil || !aws.BoolValue(logSetup.Enabled) {
+			if !aws.ToBool(logSetup.Enabled) {
 				continue
 			}
 
-			enabledLogTypes = append(enabledLogTypes, logSetup.Types...)
+			enabledLogTypes = append(enabledLogTypes, enum.Slice(logSetup.Types...)...)
 		}
 	}
 
-	return flex.FlattenStringSet(enabledLogTypes)
+	return flex.FlattenStringValueSet(enabledLogTypes)
 }
 
-func flattenKubernetesNetworkConfigResponse(apiObject *eks.KubernetesNetworkConfigResponse) []interface{} {
+func flattenKubernetesNetworkConfigResponse(apiObject *awstypes.KubernetesNetworkConfigResponse) []interface{} {
 	if apiObject == nil {
 		return nil
 	}
 
 	tfMap := map[string]interface{}{
-		"service_ipv4_cidr": aws.StringValue(apiObject.ServiceIpv4Cidr),
-		"service_ipv6_cidr": aws.StringValue(apiObject.ServiceIpv6Cidr),
-		"ip_family":         aws.StringValue(apiObject.IpFamily),
+		"service_ipv4_cidr": aws.ToString(apiObject.ServiceIpv4Cidr),
+		"service_ipv6_cidr": aws.ToString(apiObject.ServiceIpv6Cidr),
+		"ip_family":         string(apiObject.IpFamily),
 	}
 
 	return []interface{}{tfMap}
 }
 
-func flattenOutpostConfigResponse(apiObject *eks.OutpostConfigResponse) []interface{} {
+func flattenOutpostConfigResponse(apiObject *awstypes.OutpostConfigResponse) []interface{} {
 	if apiObject == nil {
 		return nil
 	}
 
 	tfMap := map[string]interface{}{
-		"control_plane_instance_type": aws.StringValue(apiObject.ControlPlaneInstanceType),
+		"control_plane_instance_type": aws.ToString(apiObject.ControlPlaneInstanceType),
 		"control_plane_placement":     flattenControlPlanePlacementResponse(apiObject.ControlPlanePlacement),
-		"outpost_arns":                aws.StringValueSlice(apiObject.OutpostArns),
+		"outpost_arns":                apiObject.OutpostArns,
 	}
 
 	return []interface{}{tfMap}
 }
 
-func flattenControlPlanePlacementResponse(apiObject *eks.ControlPlanePlacementResponse) []interface{} {
+func flattenControlPlanePlacementResponse(apiObject *awstypes.ControlPlanePlacementResponse) []interface{} {
 	if apiObject == nil {
 		return nil
 	}
 
 	tfMap := map[string]interface{}{
-		"group_name": aws.StringValue(apiObject.GroupName),
+		"group_name": aws.ToString(apiObject.GroupName),
 	}
 
 	return []interface{}{tfMap}
@@ -0,0 +1,283 @@
+package eks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	kubeconfigAuthTypeExec  = "exec"
+	kubeconfigAuthTypeToken = "token"
+
+	kubeconfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+	kubeconfigExecCommand    = "aws"
+)
+
+func kubeconfigAuthType_Values() []string {
+	return []string{
+		kubeconfigAuthTypeExec,
+		kubeconfigAuthTypeToken,
+	}
+}
+
+// @SDKDataSource("aws_eks_cluster_kubeconfig")
+func DataSourceClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceClusterKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kubeconfigAuthTypeToken,
+				ValidateFunc: validation.StringInSlice(kubeconfigAuthType_Values(), false),
+			},
+			"certificate_authority_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"endpoint"},
+			},
+			"endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"certificate_authority_data"},
+			},
+			"exec": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"command": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validClusterName,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"role_session_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"role_arn"},
+				ValidateFunc: validation.StringLenBetween(2, 64),
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token_expiration_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      14,
+				ValidateFunc: validation.IntBetween(1, 14),
+			},
+		},
+	}
+}
+
+func dataSourceClusterKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := client.EKSClient(ctx)
+
+	name := d.Get("name").(string)
+	endpoint := d.Get("endpoint").(string)
+	caData := d.Get("certificate_authority_data").(string)
+
+	// The cluster is only described when its endpoint and CA data aren't supplied.
+	if endpoint == "" {
+		cluster, err := FindClusterByName(ctx, conn, name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s): %s", name, err)
+		}
+
+		if cluster.CertificateAuthority != nil {
+			caData = aws.ToString(cluster.CertificateAuthority.Data)
+		}
+		endpoint = aws.ToString(cluster.Endpoint)
+	}
+
+	stsConn := client.STSConn(ctx)
+	roleARN := d.Get("role_arn").(string)
+	roleSessionName := d.Get("role_session_name").(string)
+
+	if roleARN != "" {
+		stsConn = assumeRoleSTSConn(client, stsConn, roleARN, roleSessionName)
+	}
+
+	generator, err := NewGenerator(false, false)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting token generator: %s", err)
+	}
+
+	expiration := time.Duration(d.Get("token_expiration_minutes").(int)) * time.Minute
+	toke, err := generator.GetWithSTSAndExpiration(ctx, name, stsConn, expiration)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting token: %s", err)
+	}
+
+	exec := kubeconfigExec(name, client.Region, roleARN)
+
+	kubeconfig, err := buildKubeconfig(name, endpoint, caData, d.Get("auth_type").(string), toke.Token, exec)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building kubeconfig for EKS Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("certificate_authority_data", caData)
+	d.Set("endpoint", endpoint)
+	if err := d.Set("exec", []interface{}{exec.tfMap()}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting exec: %s", err)
+	}
+	d.Set("kubeconfig", kubeconfig)
+	d.Set("token", toke.Token)
+	d.Set("token_expiration", toke.Expiration.UTC().Format(time.RFC3339))
+
+	return diags
+}
+
+// assumeRoleSTSConn returns an STS client that signs requests with credentials for the given role,
+// preserving any endpoint and region configuration of the provider's STS client.
+func assumeRoleSTSConn(client *conns.AWSClient, conn *sts.STS, roleARN, sessionName string) *sts.STS {
+	sess := client.Session.Copy(&conn.Config)
+	creds := stscreds.NewCredentials(sess, roleARN, func(p *stscreds.AssumeRoleProvider) {
+		if sessionName != "" {
+			p.RoleSessionName = sessionName
+		}
+	})
+
+	return sts.New(sess, &aws_sdkv1.Config{Credentials: creds})
+}
+
+type kubeconfigExecConfig struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Command    string   `yaml:"command"`
+	Args       []string `yaml:"args"`
+}
+
+func (c kubeconfigExecConfig) tfMap() map[string]interface{} {
+	return map[string]interface{}{
+		"api_version": c.APIVersion,
+		"args":        c.Args,
+		"command":     c.Command,
+	}
+}
+
+// kubeconfigExec returns the exec plugin configuration for `aws eks get-token`.
+// The command has no option for the role session name, so it is only used for the generated token.
+func kubeconfigExec(clusterName, region, roleARN string) kubeconfigExecConfig {
+	args := []string{"--region", region, "eks", "get-token", "--cluster-name", clusterName, "--output", "json"}
+
+	if roleARN != "" {
+		args = append(args, "--role-arn", roleARN)
+	}
+
+	return kubeconfigExecConfig{
+		APIVersion: kubeconfigExecAPIVersion,
+		Command:    kubeconfigExecCommand,
+		Args:       args,
+	}
+}
+
+type kubeconfigDocument struct {
+	APIVersion     string                 `yaml:"apiVersion"`
+	Kind           string                 `yaml:"kind"`
+	Clusters       []kubeconfigNamedEntry `yaml:"clusters"`
+	Contexts       []kubeconfigNamedEntry `yaml:"contexts"`
+	CurrentContext string                 `yaml:"current-context"`
+	Preferences    map[string]interface{} `yaml:"preferences"`
+	Users          []kubeconfigNamedEntry `yaml:"users"`
+}
+
+type kubeconfigNamedEntry struct {
+	Name    string                 `yaml:"name"`
+	Cluster map[string]interface{} `yaml:"cluster,omitempty"`
+	Context map[string]interface{} `yaml:"context,omitempty"`
+	User    map[string]interface{} `yaml:"user,omitempty"`
+}
+
+func buildKubeconfig(name, endpoint, caData, authType, token string, exec kubeconfigExecConfig) (string, error) {
+	user := map[string]interface{}{}
+
+	switch authType {
+	case kubeconfigAuthTypeExec:
+		user["exec"] = exec
+	default:
+		user["token"] = token
+	}
+
+	doc := kubeconfigDocument{
+		APIVersion: "v1",
+		Kind:       "Config",
+		Clusters: []kubeconfigNamedEntry{{
+			Name: name,
+			Cluster: map[string]interface{}{
+				"certificate-authority-data": caData,
+				"server":                     endpoint,
+			},
+		}},
+		Contexts: []kubeconfigNamedEntry{{
+			Name: name,
+			Context: map[string]interface{}{
+				"cluster": name,
+				"user":    name,
+			},
+		}},
+		CurrentContext: name,
+		Preferences:    map[string]interface{}{},
+		Users: []kubeconfigNamedEntry{{
+			Name: name,
+			User: user,
+		}},
+	}
+
+	output, err := yaml.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
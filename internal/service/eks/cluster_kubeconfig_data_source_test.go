@@ -0,0 +1,128 @@
+package eks_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEKSClusterKubeconfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_kubeconfig.test"
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterKubeconfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceResourceName, "auth_type", "token"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority.0.data", dataSourceResourceName, "certificate_authority_data"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint", dataSourceResourceName, "endpoint"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "exec.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "exec.0.api_version", "client.authentication.k8s.io/v1beta1"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "exec.0.command", "aws"),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`token: k8s-aws-v1\.`)),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceResourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "token"),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "token_expiration"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "token_expiration_minutes", "14"),
+					testAccCheckClusterAuthToken(dataSourceResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterKubeconfigDataSource_exec(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_kubeconfig.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterKubeconfigDataSourceConfig_exec(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceResourceName, "auth_type", "exec"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "exec.0.args.#", "8"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "exec.0.args.5", rName),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`command: aws`)),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "token_expiration_minutes", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterKubeconfigDataSource_clusterOutputs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_kubeconfig.test"
+	clusterDataSourceName := "data.aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterKubeconfigDataSourceConfig_clusterOutputs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(clusterDataSourceName, "certificate_authority.0.data", dataSourceResourceName, "certificate_authority_data"),
+					resource.TestCheckResourceAttrPair(clusterDataSourceName, "endpoint", dataSourceResourceName, "endpoint"),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`token: k8s-aws-v1\.`)),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "token"),
+					testAccCheckClusterAuthToken(dataSourceResourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterKubeconfigDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_required(rName), `
+data "aws_eks_cluster_kubeconfig" "test" {
+  name = aws_eks_cluster.test.name
+}
+`)
+}
+
+func testAccClusterKubeconfigDataSourceConfig_exec(rName string, expirationMinutes int) string {
+	return acctest.ConfigCompose(testAccClusterConfig_required(rName), fmt.Sprintf(`
+data "aws_eks_cluster_kubeconfig" "test" {
+  name                     = aws_eks_cluster.test.name
+  auth_type                = "exec"
+  token_expiration_minutes = %[1]d
+}
+`, expirationMinutes))
+}
+
+func testAccClusterKubeconfigDataSourceConfig_clusterOutputs(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_required(rName), `
+data "aws_eks_cluster" "test" {
+  name = aws_eks_cluster.test.name
+}
+
+data "aws_eks_cluster_kubeconfig" "test" {
+  name                       = data.aws_eks_cluster.test.name
+  endpoint                   = data.aws_eks_cluster.test.endpoint
+  certificate_authority_data = data.aws_eks_cluster.test.certificate_authority[0].data
+}
+`)
+}
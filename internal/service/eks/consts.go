@@ -18,6 +18,12 @@ func Resources_Values() []string {
 	}
 }
 
+// Pod Identity associations have no lifecycle status in the API; an association
+// that can be described is considered active.
+const (
+	podIdentityAssociationStatusActive = "ACTIVE"
+)
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eks"
+	smithy "github.com/aws/smithy-go"
 	multierror "github.com/hashicorp/go-multierror"
 )
 
@@ -62,6 +64,27 @@ func ErrorDetailsError(apiObjects []*eks.ErrorDetail) error {
 	return errors.ErrorOrNil()
 }
 
+func errorDetailErrorV2(apiObject awstypes.ErrorDetail) error {
+	return &smithy.GenericAPIError{
+		Code:    string(apiObject.ErrorCode),
+		Message: aws.StringValue(apiObject.ErrorMessage),
+	}
+}
+
+func errorDetailsErrorV2(apiObjects []awstypes.ErrorDetail) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		err := errorDetailErrorV2(apiObject)
+
+		if err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %w", strings.Join(apiObject.ResourceIds, ", "), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func IssueError(apiObject *eks.Issue) error {
 	if apiObject == nil {
 		return nil
@@ -0,0 +1,198 @@
+package eks_test
+
+import (
+	"testing"
+
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+)
+
+func TestAccessEntryParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectError          bool
+		ExpectedClusterName  string
+		ExpectedPrincipalARN string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:             "valid ID",
+			InputID:              tfeks.AccessEntryCreateResourceID("cluster", "arn:aws:iam::123456789012:role/test"),
+			ExpectedClusterName:  "cluster",
+			ExpectedPrincipalARN: "arn:aws:iam::123456789012:role/test",
+		},
+		{
+			TestName:             "valid ID comma in principal ARN",
+			InputID:              tfeks.AccessEntryCreateResourceID("cluster", "arn:aws:iam::123456789012:role/a,b"),
+			ExpectedClusterName:  "cluster",
+			ExpectedPrincipalARN: "arn:aws:iam::123456789012:role/a,b",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			gotClusterName, gotPrincipalARN, err := tfeks.AccessEntryParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotClusterName != testCase.ExpectedClusterName {
+				t.Errorf("got ClusterName %s, expected %s", gotClusterName, testCase.ExpectedClusterName)
+			}
+
+			if gotPrincipalARN != testCase.ExpectedPrincipalARN {
+				t.Errorf("got PrincipalARN %s, expected %s", gotPrincipalARN, testCase.ExpectedPrincipalARN)
+			}
+		})
+	}
+}
+
+func TestAccessPolicyAssociationParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectError          bool
+		ExpectedClusterName  string
+		ExpectedPrincipalARN string
+		ExpectedPolicyARN    string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "cluster,arn:aws:iam::123456789012:role/test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty principal ARN",
+			InputID:     "cluster,,arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy",
+			ExpectError: true,
+		},
+		{
+			TestName:             "valid ID",
+			InputID:              tfeks.AccessPolicyAssociationCreateResourceID("cluster", "arn:aws:iam::123456789012:role/test", "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"),
+			ExpectedClusterName:  "cluster",
+			ExpectedPrincipalARN: "arn:aws:iam::123456789012:role/test",
+			ExpectedPolicyARN:    "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy",
+		},
+		{
+			TestName:             "valid ID commas in principal ARN",
+			InputID:              tfeks.AccessPolicyAssociationCreateResourceID("cluster", "arn:aws:iam::123456789012:role/a,b,c", "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"),
+			ExpectedClusterName:  "cluster",
+			ExpectedPrincipalARN: "arn:aws:iam::123456789012:role/a,b,c",
+			ExpectedPolicyARN:    "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			gotClusterName, gotPrincipalARN, gotPolicyARN, err := tfeks.AccessPolicyAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotClusterName != testCase.ExpectedClusterName {
+				t.Errorf("got ClusterName %s, expected %s", gotClusterName, testCase.ExpectedClusterName)
+			}
+
+			if gotPrincipalARN != testCase.ExpectedPrincipalARN {
+				t.Errorf("got PrincipalARN %s, expected %s", gotPrincipalARN, testCase.ExpectedPrincipalARN)
+			}
+
+			if gotPolicyARN != testCase.ExpectedPolicyARN {
+				t.Errorf("got PolicyARN %s, expected %s", gotPolicyARN, testCase.ExpectedPolicyARN)
+			}
+		})
+	}
+}
+
+func TestPodIdentityAssociationParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName              string
+		InputID               string
+		ExpectError           bool
+		ExpectedClusterName   string
+		ExpectedAssociationID string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty association ID",
+			InputID:     "cluster,",
+			ExpectError: true,
+		},
+		{
+			TestName:              "valid ID",
+			InputID:               tfeks.PodIdentityAssociationCreateResourceID("cluster", "a-12345678901234567"),
+			ExpectedClusterName:   "cluster",
+			ExpectedAssociationID: "a-12345678901234567",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			gotClusterName, gotAssociationID, err := tfeks.PodIdentityAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotClusterName != testCase.ExpectedClusterName {
+				t.Errorf("got ClusterName %s, expected %s", gotClusterName, testCase.ExpectedClusterName)
+			}
+
+			if gotAssociationID != testCase.ExpectedAssociationID {
+				t.Errorf("got AssociationID %s, expected %s", gotAssociationID, testCase.ExpectedAssociationID)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package eks
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_eks_pod_identity_associations")
+func DataSourcePodIdentityAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePodIdentityAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validClusterName,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_account": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourcePodIdentityAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+
+	input := &eks.ListPodIdentityAssociationsInput{
+		ClusterName: aws.String(clusterName),
+	}
+
+	if v, ok := d.GetOk("namespace"); ok {
+		input.Namespace = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("service_account"); ok {
+		input.ServiceAccount = aws.String(v.(string))
+	}
+
+	var associations []awstypes.PodIdentityAssociation
+
+	pages := eks.NewListPodIdentityAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing EKS Pod Identity Associations: %s", err)
+		}
+
+		// The IAM role isn't returned by ListPodIdentityAssociations.
+		for _, v := range page.Associations {
+			associationID := aws.ToString(v.AssociationId)
+			association, err := FindPodIdentityAssociationByTwoPartKey(ctx, conn, clusterName, associationID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EKS Pod Identity Association (%s): %s", associationID, err)
+			}
+
+			associations = append(associations, *association)
+		}
+	}
+
+	d.SetId(strings.Join([]string{clusterName, d.Get("namespace").(string), d.Get("service_account").(string)}, podIdentityAssociationResourceIDSeparator))
+
+	if err := d.Set("associations", flattenPodIdentityAssociations(associations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting associations: %s", err)
+	}
+	d.Set("cluster_name", clusterName)
+
+	return diags
+}
+
+func flattenPodIdentityAssociations(apiObjects []awstypes.PodIdentityAssociation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"association_arn": aws.ToString(apiObject.AssociationArn),
+			"association_id":  aws.ToString(apiObject.AssociationId),
+			"namespace":       aws.ToString(apiObject.Namespace),
+			"role_arn":        aws.ToString(apiObject.RoleArn),
+			"service_account": aws.ToString(apiObject.ServiceAccount),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
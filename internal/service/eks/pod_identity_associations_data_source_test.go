@@ -0,0 +1,47 @@
+package eks_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEKSPodIdentityAssociationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_pod_identity_associations.test"
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "cluster_name", "aws_eks_cluster.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "associations.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "associations.0.association_arn", resourceName, "association_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "associations.0.association_id", resourceName, "association_id"),
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "associations.0.namespace", resourceName, "namespace"),
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "associations.0.role_arn", resourceName, "role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "associations.0.service_account", resourceName, "service_account"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPodIdentityAssociationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationConfig_basic(rName, "test1"), `
+data "aws_eks_pod_identity_associations" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  namespace    = aws_eks_pod_identity_association.test.namespace
+}
+`)
+}
@@ -3,6 +3,7 @@ package eks
 import (
 	"context"
 
+	eks_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -104,3 +105,19 @@ func statusOIDCIdentityProviderConfig(ctx context.Context, conn *eks.EKS, cluste
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusPodIdentityAssociation(ctx context.Context, conn *eks_sdkv2.Client, clusterName, associationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPodIdentityAssociationByTwoPartKey(ctx, conn, clusterName, associationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, podIdentityAssociationStatusActive, nil
+	}
+}
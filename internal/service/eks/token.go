@@ -12,6 +12,7 @@ With the following modifications:
  - Ignore errorlint reports
  - Refactor deprecated io/ioutil in Go 1.16
  - Adds context parameter
+ - Adds GetWithSTSAndExpiration to allow a shorter reported token expiration
 */
 
 /*
@@ -160,6 +161,9 @@ type getCallerIdentityWrapper struct {
 type Generator interface {
 	// GetWithSTS returns a token valid for clusterID using the given STS client.
 	GetWithSTS(ctx context.Context, clusterID string, stsAPI *sts.STS) (Token, error)
+	// GetWithSTSAndExpiration returns a token valid for clusterID using the given STS client,
+	// reporting an expiration no later than the given duration from now.
+	GetWithSTSAndExpiration(ctx context.Context, clusterID string, stsAPI *sts.STS, expiration time.Duration) (Token, error)
 }
 
 type generator struct {
@@ -199,6 +203,22 @@ func (g generator) GetWithSTS(ctx context.Context, clusterID string, stsAPI *sts
 	return Token{v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURLString)), tokenExpiration}, nil
 }
 
+// GetWithSTSAndExpiration returns a token valid for clusterID using the given STS client.
+// STS always honours presigned URLs for 15 minutes, so expiration only shortens the reported
+// token expiration and values above that limit are capped.
+func (g generator) GetWithSTSAndExpiration(ctx context.Context, clusterID string, stsAPI *sts.STS, expiration time.Duration) (Token, error) {
+	token, err := g.GetWithSTS(ctx, clusterID, stsAPI)
+	if err != nil {
+		return Token{}, err
+	}
+
+	if expiration > 0 && expiration < presignedURLExpiration-1*time.Minute {
+		token.Expiration = time.Now().Local().Add(expiration)
+	}
+
+	return token, nil
+}
+
 // Verifier validates tokens by calling STS and returning the associated identity.
 type Verifier interface {
 	Verify(token string) (*Identity, error)
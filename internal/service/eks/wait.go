@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	eks_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -207,3 +209,38 @@ func waitOIDCIdentityProviderConfigDeleted(ctx context.Context, conn *eks.EKS, c
 
 	return nil, err
 }
+
+func waitPodIdentityAssociationCreated(ctx context.Context, conn *eks_sdkv2.Client, clusterName, associationID string, timeout time.Duration) (*awstypes.PodIdentityAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    []string{podIdentityAssociationStatusActive},
+		Refresh:                   statusPodIdentityAssociation(ctx, conn, clusterName, associationID),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.PodIdentityAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPodIdentityAssociationDeleted(ctx context.Context, conn *eks_sdkv2.Client, clusterName, associationID string, timeout time.Duration) (*awstypes.PodIdentityAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{podIdentityAssociationStatusActive},
+		Target:  []string{},
+		Refresh: statusPodIdentityAssociation(ctx, conn, clusterName, associationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.PodIdentityAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -79,7 +79,7 @@ func DataSourceVirtualCluster() *schema.Resource {
 
 func dataSourceVirtualClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EMRContainersConn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	id := d.Get("virtual_cluster_id").(string)
 	vc, err := FindVirtualClusterByID(ctx, conn, id)
@@ -151,7 +151,7 @@ func dataSourceWindowsFileSystemRead(ctx context.Context, d *schema.ResourceData
 
 	conn := meta.(*conns.AWSClient).FSxConn(ctx)
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	id := d.Get("id").(string)
 	filesystem, err := FindFileSystemByID(ctx, conn, id)
@@ -102,7 +102,7 @@ func (d *dataSourceAccelerator) Read(ctx context.Context, request datasource.Rea
 	}
 
 	conn := d.Meta().GlobalAcceleratorConn(ctx)
-	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig(ctx)
 
 	var results []*globalaccelerator.Accelerator
 	err := conn.ListAcceleratorsPagesWithContext(ctx, &globalaccelerator.ListAcceleratorsInput{}, func(page *globalaccelerator.ListAcceleratorsOutput, lastPage bool) bool {
@@ -108,7 +108,7 @@ func DataSourceWorkspace() *schema.Resource {
 func dataSourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	workspaceID := d.Get("workspace_id").(string)
 	workspace, err := FindWorkspaceByID(ctx, conn, workspaceID)
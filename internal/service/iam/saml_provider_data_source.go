@@ -46,7 +46,7 @@ func DataSourceSAMLProvider() *schema.Resource {
 
 func dataSourceSAMLProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).IAMConn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	arn := d.Get("arn").(string)
 	output, err := FindSAMLProviderByARN(ctx, conn, arn)
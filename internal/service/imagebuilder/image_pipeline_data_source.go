@@ -164,7 +164,7 @@ func dataSourceImagePipelineRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.Set("status", imagePipeline.Status)
-	d.Set("tags", KeyValueTags(ctx, imagePipeline.Tags).IgnoreAWS().IgnoreConfig(meta.(*conns.AWSClient).IgnoreTagsConfig).Map())
+	d.Set("tags", KeyValueTags(ctx, imagePipeline.Tags).IgnoreAWS().IgnoreConfig(meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)).Map())
 
 	return diags
 }
@@ -106,7 +106,7 @@ func DataSourceThesaurus() *schema.Resource {
 
 func dataSourceThesaurusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).KendraClient(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	thesaurusID := d.Get("thesaurus_id").(string)
 	indexID := d.Get("index_id").(string)
@@ -0,0 +1,218 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Function Recursion Config")
+func newResourceFunctionRecursionConfig(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceFunctionRecursionConfig{}, nil
+}
+
+type resourceFunctionRecursionConfig struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceFunctionRecursionConfig) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lambda_function_recursion_config"
+}
+
+func (r *resourceFunctionRecursionConfig) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"recursive_loop": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Values[awstypes.RecursiveLoop]()...),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceFunctionRecursionConfig) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceFunctionRecursionConfigData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	functionName := data.FunctionName.ValueString()
+	input := &lambda.PutFunctionRecursionConfigInput{
+		FunctionName:  aws.String(functionName),
+		RecursiveLoop: awstypes.RecursiveLoop(data.RecursiveLoop.ValueString()),
+	}
+
+	_, err := conn.PutFunctionRecursionConfig(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lambda Function Recursion Config (%s)", functionName), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(functionName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceFunctionRecursionConfig) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceFunctionRecursionConfigData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	functionName := data.ID.ValueString()
+	output, err := FindFunctionRecursionConfigByName(ctx, conn, functionName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lambda Function Recursion Config (%s)", functionName), err.Error())
+
+		return
+	}
+
+	data.FunctionName = types.StringValue(functionName)
+	data.RecursiveLoop = flex.StringValueToFramework(ctx, output.RecursiveLoop)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceFunctionRecursionConfig) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceFunctionRecursionConfigData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	if !new.RecursiveLoop.Equal(old.RecursiveLoop) {
+		functionName := new.FunctionName.ValueString()
+		input := &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(functionName),
+			RecursiveLoop: awstypes.RecursiveLoop(new.RecursiveLoop.ValueString()),
+		}
+
+		_, err := conn.PutFunctionRecursionConfig(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Lambda Function Recursion Config (%s)", functionName), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete restores the default recursive loop detection, as recursion configuration cannot be removed.
+func (r *resourceFunctionRecursionConfig) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceFunctionRecursionConfigData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	functionName := data.FunctionName.ValueString()
+	_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+		FunctionName:  aws.String(functionName),
+		RecursiveLoop: awstypes.RecursiveLoopTerminate,
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lambda Function Recursion Config (%s)", functionName), err.Error())
+
+		return
+	}
+}
+
+type resourceFunctionRecursionConfigData struct {
+	FunctionName  types.String `tfsdk:"function_name"`
+	ID            types.String `tfsdk:"id"`
+	RecursiveLoop types.String `tfsdk:"recursive_loop"`
+}
+
+func FindFunctionRecursionConfigByName(ctx context.Context, conn *lambda.Client, functionName string) (*lambda.GetFunctionRecursionConfigOutput, error) {
+	input := &lambda.GetFunctionRecursionConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	output, err := conn.GetFunctionRecursionConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,302 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Runtime Management Config")
+func newResourceRuntimeManagementConfig(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceRuntimeManagementConfig{}, nil
+}
+
+type resourceRuntimeManagementConfig struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceRuntimeManagementConfig) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lambda_runtime_management_config"
+}
+
+func (r *resourceRuntimeManagementConfig) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"function_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"runtime_version_arn": schema.StringAttribute{
+				Optional: true,
+			},
+			"update_runtime_on": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Values[awstypes.UpdateRuntimeOn]()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceRuntimeManagementConfig) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceRuntimeManagementConfigData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	id := RuntimeManagementConfigCreateResourceID(data.FunctionName.ValueString(), data.Qualifier.ValueString())
+	output, err := conn.PutRuntimeManagementConfig(ctx, data.expandPutInput(ctx))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lambda Runtime Management Config (%s)", id), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.FunctionArn = flex.StringToFramework(ctx, output.FunctionArn)
+	data.UpdateRuntimeOn = flex.StringValueToFramework(ctx, output.UpdateRuntimeOn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRuntimeManagementConfig) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceRuntimeManagementConfigData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	id := data.ID.ValueString()
+	functionName, qualifier, err := RuntimeManagementConfigParseResourceID(id)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	output, err := FindRuntimeManagementConfigByTwoPartKey(ctx, conn, functionName, qualifier)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lambda Runtime Management Config (%s)", id), err.Error())
+
+		return
+	}
+
+	data.FunctionArn = flex.StringToFramework(ctx, output.FunctionArn)
+	data.FunctionName = types.StringValue(functionName)
+	data.Qualifier = flex.StringValueToFramework(ctx, qualifier)
+	data.RuntimeVersionArn = flex.StringToFramework(ctx, output.RuntimeVersionArn)
+	data.UpdateRuntimeOn = flex.StringValueToFramework(ctx, output.UpdateRuntimeOn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRuntimeManagementConfig) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceRuntimeManagementConfigData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	if !new.RuntimeVersionArn.Equal(old.RuntimeVersionArn) || !new.UpdateRuntimeOn.Equal(old.UpdateRuntimeOn) {
+		output, err := conn.PutRuntimeManagementConfig(ctx, new.expandPutInput(ctx))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Lambda Runtime Management Config (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.FunctionArn = flex.StringToFramework(ctx, output.FunctionArn)
+		new.UpdateRuntimeOn = flex.StringValueToFramework(ctx, output.UpdateRuntimeOn)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete restores the default runtime update mode, as runtime management configuration cannot be removed.
+func (r *resourceRuntimeManagementConfig) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceRuntimeManagementConfigData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    flex.StringFromFramework(ctx, data.FunctionName),
+		UpdateRuntimeOn: awstypes.UpdateRuntimeOnAuto,
+	}
+
+	if v := data.Qualifier.ValueString(); v != "" {
+		input.Qualifier = aws.String(v)
+	}
+
+	_, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lambda Runtime Management Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceRuntimeManagementConfigData struct {
+	FunctionArn       types.String `tfsdk:"function_arn"`
+	FunctionName      types.String `tfsdk:"function_name"`
+	ID                types.String `tfsdk:"id"`
+	Qualifier         types.String `tfsdk:"qualifier"`
+	RuntimeVersionArn types.String `tfsdk:"runtime_version_arn"`
+	UpdateRuntimeOn   types.String `tfsdk:"update_runtime_on"`
+}
+
+func (data *resourceRuntimeManagementConfigData) expandPutInput(ctx context.Context) *lambda.PutRuntimeManagementConfigInput {
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    flex.StringFromFramework(ctx, data.FunctionName),
+		UpdateRuntimeOn: awstypes.UpdateRuntimeOnAuto,
+	}
+
+	if v := data.Qualifier.ValueString(); v != "" {
+		input.Qualifier = aws.String(v)
+	}
+
+	if v := data.RuntimeVersionArn.ValueString(); v != "" {
+		input.RuntimeVersionArn = aws.String(v)
+	}
+
+	if v := data.UpdateRuntimeOn.ValueString(); v != "" {
+		input.UpdateRuntimeOn = awstypes.UpdateRuntimeOn(v)
+	}
+
+	return input
+}
+
+func FindRuntimeManagementConfigByTwoPartKey(ctx context.Context, conn *lambda.Client, functionName, qualifier string) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	input := &lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+const runtimeManagementConfigResourceIDSeparator = "/"
+
+func RuntimeManagementConfigCreateResourceID(functionName, qualifier string) string {
+	if qualifier == "" {
+		return functionName
+	}
+
+	parts := []string{functionName, qualifier}
+	id := strings.Join(parts, runtimeManagementConfigResourceIDSeparator)
+
+	return id
+}
+
+func RuntimeManagementConfigParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, runtimeManagementConfigResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME%[2]qQUALIFIER or FUNCTION-NAME", id, runtimeManagementConfigResourceIDSeparator)
+}
@@ -0,0 +1,161 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// sourceDirModified is the timestamp recorded for every archive entry.
+// It is the earliest time representable in the ZIP (MS-DOS) format.
+var sourceDirModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// zipSourceDir returns a ZIP archive of the regular files under the specified directory.
+// Entries are written in lexical order with fixed timestamps and permissions so that the
+// archive, and therefore its hash, depends only on file names, contents and executability.
+func zipSourceDir(v string) ([]byte, error) {
+	dir, err := homedir.Expand(v)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	var paths []string
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		paths = append(paths, path)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.Mode().IsRegular() {
+			continue
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return nil, err
+		}
+
+		header := &zip.FileHeader{
+			Name:     filepath.ToSlash(rel),
+			Method:   zip.Deflate,
+			Modified: sourceDirModified,
+		}
+
+		mode := fs.FileMode(0644)
+		if info.Mode()&0111 != 0 {
+			mode = 0755
+		}
+		header.SetMode(mode)
+
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := f.Write(contents); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// sourceDirCodeSha256 returns the hash of the archive of the specified directory,
+// in the same format as the CodeSha256 reported by Lambda.
+func sourceDirCodeSha256(v string) (string, error) {
+	zipFile, err := zipSourceDir(v)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(zipFile)
+
+	return base64.StdEncoding.EncodeToString(hash[:]), nil
+}
+
+// sourceDirDiffSuppress suppresses changes to the source_dir path, e.g. between machines,
+// when the contents of the new directory match the deployed package.
+func sourceDirDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" || d.Id() == "" {
+		return false
+	}
+
+	hash, err := sourceDirCodeSha256(new)
+	if err != nil {
+		return false
+	}
+
+	return hash == d.Get("source_code_hash").(string)
+}
+
+// sourceDirCustomizeDiff sets source_code_hash to the hash of the source_dir archive so that
+// the package is only uploaded when the directory contents change.
+func sourceDirCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v := d.Get("source_dir").(string)
+	if v == "" {
+		return nil
+	}
+
+	hash, err := sourceDirCodeSha256(v)
+	if err != nil {
+		return fmt.Errorf("archiving source_dir (%s): %w", v, err)
+	}
+
+	if old, _ := d.GetChange("source_code_hash"); old.(string) == hash {
+		return nil
+	}
+
+	return d.SetNew("source_code_hash", hash)
+}
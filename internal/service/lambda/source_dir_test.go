@@ -0,0 +1,128 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestZipSourceDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeSourceDirFile(t, dir, "index.js", "exports.handler = async () => {};", 0644)
+	writeSourceDirFile(t, dir, "lib/util.js", "module.exports = {};", 0644)
+	writeSourceDirFile(t, dir, "bin/run", "#!/bin/sh", 0755)
+
+	zipFile, err := zipSourceDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(zipFile), int64(len(zipFile)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wantNames := []string{"bin/run", "index.js", "lib/util.js"}
+	if len(r.File) != len(wantNames) {
+		t.Fatalf("expected %d entries, got %d", len(wantNames), len(r.File))
+	}
+
+	for i, f := range r.File {
+		if f.Name != wantNames[i] {
+			t.Errorf("entry %d: expected name %q, got %q", i, wantNames[i], f.Name)
+		}
+
+		if !f.Modified.Equal(sourceDirModified) {
+			t.Errorf("entry %q: expected modification time %s, got %s", f.Name, sourceDirModified, f.Modified)
+		}
+	}
+
+	if got, want := r.File[0].Mode().Perm(), os.FileMode(0755); got != want {
+		t.Errorf("entry %q: expected mode %s, got %s", r.File[0].Name, want, got)
+	}
+
+	if got, want := r.File[1].Mode().Perm(), os.FileMode(0644); got != want {
+		t.Errorf("entry %q: expected mode %s, got %s", r.File[1].Name, want, got)
+	}
+}
+
+func TestSourceDirCodeSha256(t *testing.T) {
+	t.Parallel()
+
+	dir1 := t.TempDir()
+	writeSourceDirFile(t, dir1, "index.js", "exports.handler = async () => {};", 0644)
+	writeSourceDirFile(t, dir1, "lib/util.js", "module.exports = {};", 0644)
+
+	// Same contents written in a different order with different timestamps.
+	dir2 := t.TempDir()
+	writeSourceDirFile(t, dir2, "lib/util.js", "module.exports = {};", 0644)
+	writeSourceDirFile(t, dir2, "index.js", "exports.handler = async () => {};", 0644)
+	past := time.Now().Add(-24 * time.Hour)
+	if err := os.Chtimes(filepath.Join(dir2, "index.js"), past, past); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	hash1, err := sourceDirCodeSha256(dir1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	hash2, err := sourceDirCodeSha256(dir2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash1 != hash2 {
+		t.Errorf("expected identical hashes, got %q and %q", hash1, hash2)
+	}
+
+	writeSourceDirFile(t, dir2, "index.js", "exports.handler = async () => { return 1; };", 0644)
+
+	hash3, err := sourceDirCodeSha256(dir2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash1 == hash3 {
+		t.Errorf("expected hash to change with contents, got %q", hash3)
+	}
+}
+
+func TestZipSourceDir_notDirectory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeSourceDirFile(t, dir, "index.js", "", 0644)
+
+	if _, err := zipSourceDir(filepath.Join(dir, "index.js")); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if _, err := zipSourceDir(filepath.Join(dir, "missing")); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func writeSourceDirFile(t *testing.T, dir, name, contents string, mode os.FileMode) {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := os.WriteFile(path, []byte(contents), mode); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Ensure the requested mode regardless of umask.
+	if err := os.Chmod(path, mode); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
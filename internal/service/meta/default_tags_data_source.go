@@ -56,7 +56,7 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 	}
 
 	defaultTagsConfig := d.Meta().DefaultTagsConfig
-	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig(ctx)
 	tags := defaultTagsConfig.GetTags()
 
 	data.ID = types.StringValue(d.Meta().Partition)
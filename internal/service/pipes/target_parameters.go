@@ -915,7 +915,7 @@ func expandBatchArrayProperties(tfMap map[string]interface{}) *types.BatchArrayP
 	apiObject := &types.BatchArrayProperties{}
 
 	if v, ok := tfMap["size"].(int); ok {
-		apiObject.Size = int32(v)
+		apiObject.Size = aws.Int32(int32(v))
 	}
 
 	return apiObject
@@ -1087,7 +1087,7 @@ func expandBatchRetryStrategy(tfMap map[string]interface{}) *types.BatchRetryStr
 	apiObject := &types.BatchRetryStrategy{}
 
 	if v, ok := tfMap["attempts"].(int); ok {
-		apiObject.Attempts = int32(v)
+		apiObject.Attempts = aws.Int32(int32(v))
 	}
 
 	return apiObject
@@ -1513,7 +1513,7 @@ func expandECSEphemeralStorage(tfMap map[string]interface{}) *types.EcsEphemeral
 	apiObject := &types.EcsEphemeralStorage{}
 
 	if v, ok := tfMap["size_in_gib"].(int); ok {
-		apiObject.SizeInGiB = int32(v)
+		apiObject.SizeInGiB = aws.Int32(int32(v))
 	}
 
 	return apiObject
@@ -1958,7 +1958,7 @@ func flattenBatchArrayProperties(apiObject *types.BatchArrayProperties) map[stri
 
 	tfMap := map[string]interface{}{}
 
-	if v := apiObject.Size; v != 0 {
+	if v := aws.ToInt32(apiObject.Size); v != 0 {
 		tfMap["size"] = int(v)
 	}
 
@@ -2082,7 +2082,7 @@ func flattenBatchRetryStrategy(apiObject *types.BatchRetryStrategy) map[string]i
 
 	tfMap := map[string]interface{}{}
 
-	if v := apiObject.Attempts; v != 0 {
+	if v := aws.ToInt32(apiObject.Attempts); v != 0 {
 		tfMap["attempts"] = int(v)
 	}
 
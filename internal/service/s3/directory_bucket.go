@@ -0,0 +1,417 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	// Directory bucket names have the form "bucket-base-name--azid--x-s3".
+	// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/directory-bucket-naming-rules.html.
+	directoryBucketNameRegex = regexp.MustCompile(`^([0-9a-z][0-9a-z-]*)--([0-9a-z]+-az[0-9]+)--x-s3$`)
+)
+
+// @FrameworkResource(name="Directory Bucket")
+func newResourceDirectoryBucket(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceDirectoryBucket{}, nil
+}
+
+type resourceDirectoryBucket struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceDirectoryBucket) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_bucket"
+}
+
+func (r *resourceDirectoryBucket) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"bucket": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(directoryBucketNameRegex, `must be in the format [bucket_name]--[azid]--x-s3`),
+				},
+			},
+			"data_redundancy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(awstypes.DataRedundancySingleAvailabilityZone)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Values[awstypes.DataRedundancy]()...),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(awstypes.BucketTypeDirectory)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Values[awstypes.BucketType]()...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"location": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"type": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(string(awstypes.LocationTypeAvailabilityZone)),
+							Validators: []validator.String{
+								stringvalidator.OneOf(enum.Values[awstypes.LocationType]()...),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceDirectoryBucket) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceDirectoryBucketData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket := data.Bucket.ValueString()
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		CreateBucketConfiguration: &awstypes.CreateBucketConfiguration{
+			Bucket: &awstypes.BucketInfo{
+				DataRedundancy: awstypes.DataRedundancy(data.DataRedundancy.ValueString()),
+				Type:           awstypes.BucketType(data.Type.ValueString()),
+			},
+			Location: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.Location, r.expandLocationInfo),
+		},
+	}
+
+	_, err := conn.CreateBucket(ctx, input, useDirectoryBucketAddressing)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Bucket (%s)", bucket), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = types.StringValue(directoryBucketARN(r.Meta(), bucket))
+	data.ID = types.StringValue(bucket)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDirectoryBucket) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceDirectoryBucketData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket := data.ID.ValueString()
+	err := FindDirectoryBucketByName(ctx, conn, bucket)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Bucket (%s)", bucket), err.Error())
+
+		return
+	}
+
+	data.ARN = types.StringValue(directoryBucketARN(r.Meta(), bucket))
+	data.Bucket = types.StringValue(bucket)
+
+	// The bucket's configuration is not returned by the API.
+	// On import it is derived from the bucket name and the defaults.
+	if data.DataRedundancy.IsNull() {
+		data.DataRedundancy = flex.StringValueToFramework(ctx, awstypes.DataRedundancySingleAvailabilityZone)
+	}
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+	if data.Location.IsNull() {
+		if m := directoryBucketNameRegex.FindStringSubmatch(bucket); len(m) == 3 {
+			data.Location = r.flattenLocationInfo(ctx, &awstypes.LocationInfo{
+				Name: aws.String(m[2]),
+				Type: awstypes.LocationTypeAvailabilityZone,
+			})
+		}
+	}
+	if data.Type.IsNull() {
+		data.Type = flex.StringValueToFramework(ctx, awstypes.BucketTypeDirectory)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDirectoryBucket) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new resourceDirectoryBucketData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only force_destroy can be updated, and it is not sent to the API.
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceDirectoryBucket) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceDirectoryBucketData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket := data.ID.ValueString()
+	input := &s3.DeleteBucketInput{
+		Bucket: aws.String(bucket),
+	}
+
+	tflog.Debug(ctx, "deleting S3 Directory Bucket", map[string]interface{}{
+		"id": bucket,
+	})
+	_, err := conn.DeleteBucket(ctx, input, useDirectoryBucketAddressing)
+
+	if tfawserr.ErrCodeEquals(err, errCodeBucketNotEmpty) && data.ForceDestroy.ValueBool() {
+		// Empty the bucket and try again.
+		_, err = emptyDirectoryBucket(ctx, conn, bucket)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("emptying S3 Directory Bucket (%s)", bucket), err.Error())
+
+			return
+		}
+
+		_, err = conn.DeleteBucket(ctx, input, useDirectoryBucketAddressing)
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Bucket (%s)", bucket), err.Error())
+
+		return
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, 2*time.Minute, func() (interface{}, error) {
+		return nil, FindDirectoryBucketByName(ctx, conn, bucket)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Directory Bucket (%s) delete", bucket), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceDirectoryBucket) expandLocationInfo(ctx context.Context, data directoryBucketLocationInfoData) *awstypes.LocationInfo {
+	return &awstypes.LocationInfo{
+		Name: flex.StringFromFramework(ctx, data.Name),
+		Type: awstypes.LocationType(data.Type.ValueString()),
+	}
+}
+
+func (r *resourceDirectoryBucket) flattenLocationInfo(ctx context.Context, apiObject *awstypes.LocationInfo) types.List {
+	attributeTypes := flex.AttributeTypesMust[directoryBucketLocationInfoData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"name": flex.StringToFramework(ctx, apiObject.Name),
+			"type": flex.StringValueToFramework(ctx, apiObject.Type),
+		}),
+	})
+}
+
+type resourceDirectoryBucketData struct {
+	ARN            types.String `tfsdk:"arn"`
+	Bucket         types.String `tfsdk:"bucket"`
+	DataRedundancy types.String `tfsdk:"data_redundancy"`
+	ForceDestroy   types.Bool   `tfsdk:"force_destroy"`
+	ID             types.String `tfsdk:"id"`
+	Location       types.List   `tfsdk:"location"`
+	Type           types.String `tfsdk:"type"`
+}
+
+type directoryBucketLocationInfoData struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// isDirectoryBucket returns whether or not the specified bucket name is that of a directory bucket.
+func isDirectoryBucket(bucket string) bool {
+	return directoryBucketNameRegex.MatchString(bucket)
+}
+
+func directoryBucketARN(c *conns.AWSClient, bucket string) string {
+	return arn.ARN{
+		Partition: c.Partition,
+		Service:   "s3express",
+		Region:    c.Region,
+		AccountID: c.AccountID,
+		Resource:  "bucket/" + bucket,
+	}.String()
+}
+
+// useDirectoryBucketAddressing is passed to operations on directory buckets.
+// Directory buckets are only reachable via virtual-hosted-style requests to their zonal endpoint,
+// which the SDK resolves (and authenticates with session credentials) from the bucket name.
+func useDirectoryBucketAddressing(o *s3.Options) {
+	o.UsePathStyle = false
+}
+
+func FindDirectoryBucketByName(ctx context.Context, conn *s3.Client, bucket string) error {
+	input := &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	}
+
+	_, err := conn.HeadBucket(ctx, input, useDirectoryBucketAddressing)
+
+	if errs.IsA[*awstypes.NotFound](err) || tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	return err
+}
+
+// emptyDirectoryBucket deletes all objects from the specified directory bucket.
+// Directory buckets do not support versioning or object lock.
+// Returns the number of objects deleted.
+func emptyDirectoryBucket(ctx context.Context, conn *s3.Client, bucket string) (int64, error) {
+	var nObjects int64
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, useDirectoryBucketAddressing)
+
+		if err != nil {
+			return nObjects, fmt.Errorf("listing S3 Directory Bucket (%s) objects: %w", bucket, err)
+		}
+
+		if len(page.Contents) == 0 {
+			continue
+		}
+
+		objects := make([]awstypes.ObjectIdentifier, 0, len(page.Contents))
+		for _, v := range page.Contents {
+			objects = append(objects, awstypes.ObjectIdentifier{
+				Key: v.Key,
+			})
+		}
+
+		output, err := conn.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &awstypes.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		}, useDirectoryBucketAddressing)
+
+		if err != nil {
+			return nObjects, fmt.Errorf("deleting S3 Directory Bucket (%s) objects: %w", bucket, err)
+		}
+
+		if n := len(output.Errors); n > 0 {
+			v := output.Errors[0]
+			return nObjects, fmt.Errorf("deleting S3 Directory Bucket (%s) object (%s): %s: %s", bucket, aws.ToString(v.Key), aws.ToString(v.Code), aws.ToString(v.Message))
+		}
+
+		nObjects += int64(len(objects))
+	}
+
+	return nObjects, nil
+}
@@ -0,0 +1,88 @@
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// @FrameworkDataSource(name="Directory Buckets")
+func newDataSourceDirectoryBuckets(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceDirectoryBuckets{}, nil
+}
+
+type dataSourceDirectoryBuckets struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceDirectoryBuckets) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_buckets"
+}
+
+func (d *dataSourceDirectoryBuckets) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"buckets": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceDirectoryBuckets) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceDirectoryBucketsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3Client(ctx)
+
+	input := &s3.ListDirectoryBucketsInput{}
+	pages := s3.NewListDirectoryBucketsPaginator(conn, input)
+	var arns, buckets []string
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, useDirectoryBucketAddressing)
+
+		if err != nil {
+			response.Diagnostics.AddError("listing S3 Directory Buckets", err.Error())
+
+			return
+		}
+
+		for _, v := range page.Buckets {
+			bucket := aws.ToString(v.Name)
+			arns = append(arns, directoryBucketARN(d.Meta(), bucket))
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueListLegacy(ctx, arns)
+	data.Buckets = flex.FlattenFrameworkStringValueListLegacy(ctx, buckets)
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceDirectoryBucketsData struct {
+	ARNs    types.List   `tfsdk:"arns"`
+	Buckets types.List   `tfsdk:"buckets"`
+	ID      types.String `tfsdk:"id"`
+}
@@ -10,6 +10,7 @@ const (
 	errCodeInvalidRequest                       = "InvalidRequest"
 	errCodeMalformedPolicy                      = "MalformedPolicy"
 	errCodeMethodNotAllowed                     = "MethodNotAllowed"
+	errCodeNoSuchBucket                         = "NoSuchBucket"
 	ErrCodeNoSuchBucketPolicy                   = "NoSuchBucketPolicy"
 	errCodeNoSuchConfiguration                  = "NoSuchConfiguration"
 	ErrCodeNoSuchCORSConfiguration              = "NoSuchCORSConfiguration"
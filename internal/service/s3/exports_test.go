@@ -0,0 +1,6 @@
+package s3
+
+// Exports for use in tests only.
+var (
+	ResourceDirectoryBucket = newResourceDirectoryBucket
+)
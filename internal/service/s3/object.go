@@ -1,28 +1,29 @@
 package s3
 
 import (
-	"bytes"
 	"context"
-	"encoding/base64"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
-	"os"
 	"regexp"
 	"strings"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kms"
@@ -30,7 +31,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
-	"github.com/mitchellh/go-homedir"
 )
 
 const objectCreationTimeout = 2 * time.Minute
@@ -51,6 +51,7 @@ func ResourceObject() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			resourceObjectCustomizeDiff,
 			verify.SetTagsDiff,
+			resourceObjectDirectoryBucketCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +75,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ChecksumAlgorithm](),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -171,10 +193,10 @@ func ResourceObject() *schema.Resource {
 				Optional: true,
 			},
 			"storage_class": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ObjectStorageClass](),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -197,12 +219,13 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
+	checksumAlgorithm := d.Get("checksum_algorithm").(string)
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, objectCreationTimeout, func() (interface{}, error) {
-		return FindObjectByThreePartKey(ctx, conn, bucket, key, "")
+		return FindObjectByBucketAndKey(ctx, conn, bucket, key, checksumAlgorithm)
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -215,19 +238,20 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s): %s", d.Id(), err)
 	}
 
-	output := outputRaw.(*s3.HeadObjectOutput)
+	output := outputRaw.(*s3_sdkv2.HeadObjectOutput)
 
 	d.Set("bucket_key_enabled", output.BucketKeyEnabled)
 	d.Set("cache_control", output.CacheControl)
+	d.Set("checksum_crc32", output.ChecksumCRC32)
+	d.Set("checksum_crc32c", output.ChecksumCRC32C)
+	d.Set("checksum_sha1", output.ChecksumSHA1)
+	d.Set("checksum_sha256", output.ChecksumSHA256)
 	d.Set("content_disposition", output.ContentDisposition)
 	d.Set("content_encoding", output.ContentEncoding)
 	d.Set("content_language", output.ContentLanguage)
 	d.Set("content_type", output.ContentType)
-	metadata := flex.PointersMapToStringList(output.Metadata)
-
-	// AWS Go SDK capitalizes metadata, this is a workaround. https://github.com/aws/aws-sdk-go/issues/445
-	for k, v := range metadata {
-		delete(metadata, k)
+	metadata := make(map[string]string, len(output.Metadata))
+	for k, v := range output.Metadata {
 		metadata[strings.ToLower(k)] = v
 	}
 
@@ -246,19 +270,27 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(aws.StringValue(output.ETag), `"`))
+	// The ETag of an object in a directory bucket is not an MD5 digest of the object data.
+	d.Set("etag", strings.Trim(aws_sdkv2.ToString(output.ETag), `"`))
 
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
-	if output.StorageClass == nil {
-		d.Set("storage_class", s3.StorageClassStandard)
-	} else {
+	if output.StorageClass != "" {
 		d.Set("storage_class", output.StorageClass)
+	} else if isDirectoryBucket(bucket) {
+		d.Set("storage_class", awstypes.StorageClassExpressOnezone)
+	} else {
+		d.Set("storage_class", awstypes.StorageClassStandard)
+	}
+
+	// Objects in directory buckets cannot be tagged.
+	if isDirectoryBucket(bucket) {
+		return diags
 	}
 
 	// Retry due to S3 eventual consistency
 	tagsRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
-		return ObjectListTags(ctx, conn, bucket, key)
+		return ObjectListTags(ctx, meta.(*conns.AWSClient).S3Conn(ctx), bucket, key)
 	}, s3.ErrCodeNoSuchBucket)
 
 	if err != nil {
@@ -282,9 +314,14 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return append(diags, resourceObjectUpload(ctx, d, meta)...)
 	}
 
+	bucket := d.Get("bucket").(string)
+	if isDirectoryBucket(bucket) {
+		// Object ACLs, Object Lock and tagging are not supported for directory buckets.
+		return append(diags, resourceObjectRead(ctx, d, meta)...)
+	}
+
 	conn := meta.(*conns.AWSClient).S3Conn(ctx)
 
-	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
 	if d.HasChange("acl") {
@@ -350,9 +387,14 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
 
 	bucket := d.Get("bucket").(string)
+	if isDirectoryBucket(bucket) {
+		return append(diags, resourceObjectDirectoryBucketDelete(ctx, d, meta)...)
+	}
+
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
 	key := d.Get("key").(string)
 	// We are effectively ignoring all leading '/'s in the key name and
 	// treating multiple '/'s as a single '/' as aws.Config.DisableRestProtocolURICleaning is false
@@ -394,123 +436,102 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
-	uploader := s3manager.NewUploaderWithClient(conn)
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "opening S3 object source (%s): %s", path, err)
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
-			}
-		}()
-	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "decoding content_base64: %s", err)
-		}
-		body = bytes.NewReader(contentRaw)
-	} else {
-		body = bytes.NewReader([]byte{})
+	body, closeBody, err := openObjectContent(d)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
+	defer closeBody()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	input := &s3manager.UploadInput{
+	input := &s3_sdkv2.PutObjectInput{
 		Body:   body,
-		Bucket: aws.String(bucket),
-		Key:    aws.String(key),
+		Bucket: aws_sdkv2.String(bucket),
+		Key:    aws_sdkv2.String(key),
 	}
 
 	if v, ok := d.GetOk("acl"); ok {
-		input.ACL = aws.String(v.(string))
+		input.ACL = awstypes.ObjectCannedACL(v.(string))
 	}
 
 	if v, ok := d.GetOk("storage_class"); ok {
-		input.StorageClass = aws.String(v.(string))
+		input.StorageClass = awstypes.StorageClass(v.(string))
 	}
 
 	if v, ok := d.GetOk("cache_control"); ok {
-		input.CacheControl = aws.String(v.(string))
+		input.CacheControl = aws_sdkv2.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumAlgorithm = awstypes.ChecksumAlgorithm(v.(string))
 	}
 
 	if v, ok := d.GetOk("content_type"); ok {
-		input.ContentType = aws.String(v.(string))
+		input.ContentType = aws_sdkv2.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("metadata"); ok {
-		input.Metadata = flex.ExpandStringMap(v.(map[string]interface{}))
+		input.Metadata = flex.ExpandStringValueMap(v.(map[string]interface{}))
 	}
 
 	if v, ok := d.GetOk("content_encoding"); ok {
-		input.ContentEncoding = aws.String(v.(string))
+		input.ContentEncoding = aws_sdkv2.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("content_language"); ok {
-		input.ContentLanguage = aws.String(v.(string))
+		input.ContentLanguage = aws_sdkv2.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("content_disposition"); ok {
-		input.ContentDisposition = aws.String(v.(string))
+		input.ContentDisposition = aws_sdkv2.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("bucket_key_enabled"); ok {
-		input.BucketKeyEnabled = aws.Bool(v.(bool))
+		input.BucketKeyEnabled = aws_sdkv2.Bool(v.(bool))
 	}
 
 	if v, ok := d.GetOk("server_side_encryption"); ok {
-		input.ServerSideEncryption = aws.String(v.(string))
+		input.ServerSideEncryption = awstypes.ServerSideEncryption(v.(string))
 	}
 
 	if v, ok := d.GetOk("kms_key_id"); ok {
-		input.SSEKMSKeyId = aws.String(v.(string))
-		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+		input.SSEKMSKeyId = aws_sdkv2.String(v.(string))
+		input.ServerSideEncryption = awstypes.ServerSideEncryptionAwsKms
 	}
 
-	if len(tags) > 0 {
+	// Objects in directory buckets cannot be tagged.
+	if len(tags) > 0 && !isDirectoryBucket(bucket) {
 		// The tag-set must be encoded as URL Query parameters.
-		input.Tagging = aws.String(tags.IgnoreAWS().URLEncode())
+		input.Tagging = aws_sdkv2.String(tags.IgnoreAWS().URLEncode())
 	}
 
 	if v, ok := d.GetOk("website_redirect"); ok {
-		input.WebsiteRedirectLocation = aws.String(v.(string))
+		input.WebsiteRedirectLocation = aws_sdkv2.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
-		input.ObjectLockLegalHoldStatus = aws.String(v.(string))
+		input.ObjectLockLegalHoldStatus = awstypes.ObjectLockLegalHoldStatus(v.(string))
 	}
 
 	if v, ok := d.GetOk("object_lock_mode"); ok {
-		input.ObjectLockMode = aws.String(v.(string))
+		input.ObjectLockMode = awstypes.ObjectLockMode(v.(string))
 	}
 
 	if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	var optFns []func(*s3_sdkv2.Options)
+	if isDirectoryBucket(bucket) {
+		optFns = append(optFns, useDirectoryBucketAddressing)
+	}
+
+	if err := uploadObject(ctx, conn, input, optFns...); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -519,6 +540,31 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
 
+// resourceObjectDirectoryBucketDelete deletes an object from a directory bucket.
+// Directory buckets do not support versioning, so there is only ever a single version of the object.
+func resourceObjectDirectoryBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	_, err := conn.DeleteObject(ctx, &s3_sdkv2.DeleteObjectInput{
+		Bucket: aws_sdkv2.String(bucket),
+		Key:    aws_sdkv2.String(key),
+	}, useDirectoryBucketAddressing)
+
+	if tfawserr_sdkv2.ErrCodeEquals(err, errCodeNoSuchBucket, s3.ErrCodeNoSuchKey) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+
+	return diags
+}
+
 func resourceObjectSetKMS(ctx context.Context, d *schema.ResourceData, meta interface{}, sseKMSKeyId *string) error {
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if sseKMSKeyId != nil {
@@ -552,6 +598,12 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
+		for _, key := range objectChecksumAttributes {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
@@ -560,13 +612,75 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 		d.SetNewComputed("etag")
 	}
 
-	return nil
+	return resourceObjectChecksumCustomizeDiff(d)
+}
+
+var objectChecksumAttributes = []string{
+	"checksum_crc32",
+	"checksum_crc32c",
+	"checksum_sha1",
+	"checksum_sha256",
+}
+
+// resourceObjectChecksumCustomizeDiff detects changes to the object's content by comparing the checksum
+// of the configured content with the checksum reported by S3.
+// Unlike the ETag, the checksum does not depend on the object's encryption or on whether it was uploaded in parts.
+// A source file is read in full on every plan, unless changes to it are detected by source_hash instead.
+func resourceObjectChecksumCustomizeDiff(d *schema.ResourceDiff) error {
+	algorithm := d.Get("checksum_algorithm").(string)
+
+	if d.Id() == "" || algorithm == "" {
+		return nil
+	}
+
+	if d.Get("source").(string) != "" && d.Get("source_hash").(string) != "" {
+		return nil
+	}
+
+	for _, key := range []string{"content", "content_base64", "source"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	key := "checksum_" + strings.ToLower(algorithm)
+	old := d.Get(key).(string)
+
+	if old == "" {
+		return nil
+	}
+
+	body, closeBody, err := openObjectContent(d)
+	if err != nil {
+		return err
+	}
+	defer closeBody()
+
+	new, err := objectChecksum(body, awstypes.ChecksumAlgorithm(algorithm))
+	if err != nil {
+		return fmt.Errorf("calculating %s checksum: %w", algorithm, err)
+	}
+
+	if old == new {
+		return nil
+	}
+
+	if err := d.SetNew(key, new); err != nil {
+		return err
+	}
+
+	if err := d.SetNewComputed("etag"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("version_id")
 }
 
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -589,6 +703,34 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	return false
 }
 
+// resourceObjectDirectoryBucketCustomizeDiff rejects arguments that are not supported for objects in directory buckets.
+func resourceObjectDirectoryBucketCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !isDirectoryBucket(d.Get("bucket").(string)) {
+		return nil
+	}
+
+	for _, key := range []string{
+		"acl",
+		"kms_key_id",
+		"object_lock_legal_hold_status",
+		"object_lock_mode",
+		"object_lock_retain_until_date",
+		"tags",
+		"website_redirect",
+	} {
+		if _, ok := d.GetOk(key); ok {
+			return fmt.Errorf("%s is not supported for objects in directory buckets", key)
+		}
+	}
+
+	// Objects in directory buckets cannot be tagged, so any provider default tags do not apply.
+	if len(d.Get(names.AttrTagsAll).(map[string]interface{})) > 0 {
+		return d.SetNew(names.AttrTagsAll, map[string]string{})
+	}
+
+	return nil
+}
+
 // DeleteAllObjectVersions deletes all versions of a specified key from an S3 bucket.
 // If key is empty then all versions of all objects are deleted.
 // Set force to true to override any S3 object lock protections on object lock enabled buckets.
@@ -814,3 +956,39 @@ func FindObjectByThreePartKey(ctx context.Context, conn *s3.S3, bucket, key, eta
 
 	return output, nil
 }
+
+// FindObjectByBucketAndKey returns the metadata of the specified object using the AWS SDK for Go v2.
+// If a checksum algorithm is specified the object's checksums are also returned.
+func FindObjectByBucketAndKey(ctx context.Context, conn *s3_sdkv2.Client, bucket, key, checksumAlgorithm string) (*s3_sdkv2.HeadObjectOutput, error) {
+	input := &s3_sdkv2.HeadObjectInput{
+		Bucket: aws_sdkv2.String(bucket),
+		Key:    aws_sdkv2.String(key),
+	}
+	if checksumAlgorithm != "" {
+		input.ChecksumMode = awstypes.ChecksumModeEnabled
+	}
+
+	var optFns []func(*s3_sdkv2.Options)
+	if isDirectoryBucket(bucket) {
+		optFns = append(optFns, useDirectoryBucketAddressing)
+	}
+
+	output, err := conn.HeadObject(ctx, input, optFns...)
+
+	if errs.IsA[*awstypes.NotFound](err) || tfawserr_sdkv2.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
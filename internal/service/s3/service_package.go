@@ -3,6 +3,8 @@ package s3
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
@@ -31,3 +33,17 @@ func (p *servicePackage) CustomizeConn(ctx context.Context, conn *s3_sdkv1.S3) (
 
 	return conn, nil
 }
+
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*s3_sdkv2.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+
+	return s3_sdkv2.NewFromConfig(cfg, func(o *s3_sdkv2.Options) {
+		// Use BaseEndpoint rather than a static resolver so that endpoint rules, including
+		// the zonal endpoints used by directory buckets, remain in effect.
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.BaseEndpoint = aws_sdkv2.String(endpoint)
+		}
+		o.UsePathStyle = config["s3_use_path_style"].(bool)
+	}), nil
+}
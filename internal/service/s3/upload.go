@@ -0,0 +1,169 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Objects larger than this are uploaded in parts.
+	objectUploadMinPartSize int64 = 16 * 1024 * 1024
+	// The maximum number of parts in a multipart upload.
+	objectUploadMaxParts = int64(manager.MaxUploadParts)
+)
+
+// objectUploadPartSize returns the part size used by the S3 upload manager to upload an object of the specified size.
+// The part size is increased for very large objects so that they fit in the maximum number of parts.
+func objectUploadPartSize(size int64) int64 {
+	partSize := objectUploadMinPartSize
+
+	if size/partSize >= objectUploadMaxParts {
+		partSize = size/objectUploadMaxParts + 1
+	}
+
+	return partSize
+}
+
+type resourceGetter interface {
+	GetOk(string) (interface{}, bool)
+}
+
+// openObjectContent returns the content of an object from its source file, content or base64 content.
+// The returned function must be called to release any resources.
+func openObjectContent(d resourceGetter) (io.ReadSeeker, func(), error) {
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("opening S3 object source (%s): %w", path, err)
+		}
+
+		return file, func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+			}
+		}, nil
+	}
+
+	var body []byte
+
+	if v, ok := d.GetOk("content"); ok {
+		body = []byte(v.(string))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding content_base64: %w", err)
+		}
+		body = contentRaw
+	}
+
+	return bytes.NewReader(body), func() {}, nil
+}
+
+// readSeekerSize returns the size of the specified io.ReadSeeker, leaving its offset at the start.
+func readSeekerSize(r io.ReadSeeker) (int64, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// uploadObject uploads an object using the S3 upload manager. Objects larger than the minimum part size
+// are uploaded in parts, concurrently.
+// If a checksum algorithm is specified the checksum of each part is calculated and verified by S3,
+// and the object's checksum is a checksum of the part checksums.
+func uploadObject(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, optFns ...func(*s3.Options)) error {
+	uploader := manager.NewUploader(conn, func(u *manager.Uploader) {
+		u.ClientOptions = append(u.ClientOptions, optFns...)
+		u.PartSize = objectUploadMinPartSize
+	})
+
+	_, err := uploader.Upload(ctx, input)
+
+	return err
+}
+
+func newChecksumHash(algorithm awstypes.ChecksumAlgorithm) (hash.Hash, error) {
+	switch algorithm {
+	case awstypes.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case awstypes.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case awstypes.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case awstypes.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+}
+
+// objectChecksum returns the checksum that S3 reports for an object with the specified content
+// uploaded by uploadObject using the specified checksum algorithm.
+// The checksum of an object uploaded in parts is a checksum of the part checksums, suffixed with the number of parts.
+func objectChecksum(r io.ReadSeeker, algorithm awstypes.ChecksumAlgorithm) (string, error) {
+	size, err := readSeekerSize(r)
+	if err != nil {
+		return "", err
+	}
+
+	h, err := newChecksumHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	partSize := objectUploadPartSize(size)
+
+	if size <= partSize {
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+
+		return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var nParts int64
+	for offset := int64(0); offset < size; offset += partSize {
+		ph, err := newChecksumHash(algorithm)
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := io.CopyN(ph, r, min(partSize, size-offset)); err != nil {
+			return "", err
+		}
+
+		h.Write(ph.Sum(nil))
+		nParts++
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), nParts), nil
+}
@@ -0,0 +1,90 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func TestObjectUploadPartSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		size     int64
+		expected int64
+	}{
+		{0, objectUploadMinPartSize},
+		{objectUploadMinPartSize, objectUploadMinPartSize},
+		{objectUploadMinPartSize*objectUploadMaxParts - 1, objectUploadMinPartSize},
+		{objectUploadMinPartSize * objectUploadMaxParts, objectUploadMinPartSize + 1},
+		{objectUploadMinPartSize*objectUploadMaxParts + 1, objectUploadMinPartSize + 1},
+	}
+
+	for _, testCase := range testCases {
+		if got := objectUploadPartSize(testCase.size); got != testCase.expected {
+			t.Errorf("objectUploadPartSize(%d) = %d, expected %d", testCase.size, got, testCase.expected)
+		}
+	}
+}
+
+func TestObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		algorithm awstypes.ChecksumAlgorithm
+		expected  string
+	}{
+		{awstypes.ChecksumAlgorithmCrc32, "DUoRhQ=="},
+		{awstypes.ChecksumAlgorithmCrc32c, "yZRlqg=="},
+		{awstypes.ChecksumAlgorithmSha1, "Kq5sNclPz7QV2+lfQIuc6R7oRu0="},
+		{awstypes.ChecksumAlgorithmSha256, "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(string(testCase.algorithm), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := objectChecksum(bytes.NewReader([]byte("hello world")), testCase.algorithm)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestObjectChecksum_multipart(t *testing.T) {
+	t.Parallel()
+
+	body := bytes.Repeat([]byte("a"), int(objectUploadMinPartSize)+1)
+
+	part1 := sha256.Sum256(body[:objectUploadMinPartSize])
+	part2 := sha256.Sum256(body[objectUploadMinPartSize:])
+	want := sha256.Sum256(append(part1[:], part2[:]...))
+	expected := fmt.Sprintf("%s-2", base64.StdEncoding.EncodeToString(want[:]))
+
+	got, err := objectChecksum(bytes.NewReader(body), awstypes.ChecksumAlgorithmSha256)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
+
+func TestObjectChecksum_unsupportedAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	if _, err := objectChecksum(bytes.NewReader(nil), "MD5"); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,253 @@
+package s3control
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_access_grants_location", name="Access Grants Location")
+// @Tags
+func resourceAccessGrantsLocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessGrantsLocationCreate,
+		ReadWithoutTimeout:   resourceAccessGrantsLocationRead,
+		UpdateWithoutTimeout: resourceAccessGrantsLocationUpdate,
+		DeleteWithoutTimeout: resourceAccessGrantsLocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_grants_location_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_location_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"location_scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAccessGrantsLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateAccessGrantsLocationInput{
+		AccountId:     aws.String(accountID),
+		IAMRoleArn:    aws.String(d.Get("iam_role_arn").(string)),
+		LocationScope: aws.String(d.Get("location_scope").(string)),
+		Tags:          accessGrantsTags(KeyValueTags(ctx, getTagsIn(ctx))),
+	}
+
+	// IAM roles are eventually consistent.
+	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateAccessGrantsLocation(ctx, input)
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidIAMRole) {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	if err != nil {
+		return diag.Errorf("creating S3 Access Grants Location: %s", err)
+	}
+
+	d.SetId(AccessGrantsLocationCreateResourceID(accountID, aws.ToString(outputRaw.(*s3control.CreateAccessGrantsLocationOutput).AccessGrantsLocationId)))
+
+	return resourceAccessGrantsLocationRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, locationID, err := AccessGrantsLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindAccessGrantsLocationByTwoPartKey(ctx, conn, accountID, locationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grants Location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Access Grants Location (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_grants_location_arn", output.AccessGrantsLocationArn)
+	d.Set("access_grants_location_id", output.AccessGrantsLocationId)
+	d.Set("account_id", accountID)
+	d.Set("iam_role_arn", output.IAMRoleArn)
+	d.Set("location_scope", output.LocationScope)
+
+	tags, err := accessGrantsListTags(ctx, conn, accountID, aws.ToString(output.AccessGrantsLocationArn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for S3 Access Grants Location (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	return nil
+}
+
+func resourceAccessGrantsLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, locationID, err := AccessGrantsLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("iam_role_arn") {
+		input := &s3control.UpdateAccessGrantsLocationInput{
+			AccessGrantsLocationId: aws.String(locationID),
+			AccountId:              aws.String(accountID),
+			IAMRoleArn:             aws.String(d.Get("iam_role_arn").(string)),
+		}
+
+		_, err := tfresource.RetryWhen(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.UpdateAccessGrantsLocation(ctx, input)
+		}, func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidIAMRole) {
+				return true, err
+			}
+
+			return false, err
+		})
+
+		if err != nil {
+			return diag.Errorf("updating S3 Access Grants Location (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := accessGrantsUpdateTags(ctx, conn, accountID, d.Get("access_grants_location_arn").(string), o, n); err != nil {
+			return diag.Errorf("updating S3 Access Grants Location (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAccessGrantsLocationRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, locationID, err := AccessGrantsLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Access Grants Location: %s", d.Id())
+	_, err = conn.DeleteAccessGrantsLocation(ctx, &s3control.DeleteAccessGrantsLocationInput{
+		AccessGrantsLocationId: aws.String(locationID),
+		AccountId:              aws.String(accountID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsLocationNotExistsError) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Access Grants Location (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const accessGrantsLocationResourceIDSeparator = ","
+
+func AccessGrantsLocationCreateResourceID(accountID, locationID string) string {
+	parts := []string{accountID, locationID}
+	id := strings.Join(parts, accessGrantsLocationResourceIDSeparator)
+
+	return id
+}
+
+func AccessGrantsLocationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, accessGrantsLocationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]saccess-grants-location-id", id, accessGrantsLocationResourceIDSeparator)
+}
+
+func FindAccessGrantsLocationByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, locationID string) (*s3control.GetAccessGrantsLocationOutput, error) {
+	input := &s3control.GetAccessGrantsLocationInput{
+		AccessGrantsLocationId: aws.String(locationID),
+		AccountId:              aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrantsLocation(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsLocationNotExistsError) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,90 @@
+package s3control
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// S3 Access Grants resources are tagged using the account-scoped ListTagsForResource,
+// TagResource and UntagResource operations rather than the bucket-specific tagging APIs.
+
+func accessGrantsTags(tags tftags.KeyValueTags) []types.Tag {
+	result := make([]types.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+func keyValueTagsFromAccessGrantsTags(ctx context.Context, tags []types.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.ToString(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(ctx, m)
+}
+
+func accessGrantsListTags(ctx context.Context, conn *s3control.Client, accountID, resourceARN string) (tftags.KeyValueTags, error) {
+	input := &s3control.ListTagsForResourceInput{
+		AccountId:   aws.String(accountID),
+		ResourceArn: aws.String(resourceARN),
+	}
+
+	output, err := conn.ListTagsForResource(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTagsFromAccessGrantsTags(ctx, output.Tags), nil
+}
+
+func accessGrantsUpdateTags(ctx context.Context, conn *s3control.Client, accountID, resourceARN string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreSystem(names.S3Control); len(removedTags) > 0 {
+		input := &s3control.UntagResourceInput{
+			AccountId:   aws.String(accountID),
+			ResourceArn: aws.String(resourceARN),
+			TagKeys:     removedTags.Keys(),
+		}
+
+		_, err := conn.UntagResource(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", resourceARN, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreSystem(names.S3Control); len(updatedTags) > 0 {
+		input := &s3control.TagResourceInput{
+			AccountId:   aws.String(accountID),
+			ResourceArn: aws.String(resourceARN),
+			Tags:        accessGrantsTags(updatedTags),
+		}
+
+		_, err := conn.TagResource(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", resourceARN, err)
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,9 @@ package s3control
 
 // Exports for use in tests only.
 var (
+	ResourceAccessGrant                   = resourceAccessGrant
+	ResourceAccessGrantsInstance          = resourceAccessGrantsInstance
+	ResourceAccessGrantsLocation          = resourceAccessGrantsLocation
 	ResourceAccessPoint                   = resourceAccessPoint
 	ResourceAccessPointPolicy             = resourceAccessPointPolicy
 	ResourceAccountPublicAccessBlock      = resourceAccountPublicAccessBlock
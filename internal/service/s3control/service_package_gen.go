@@ -48,6 +48,24 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAccountPublicAccessBlock,
 			TypeName: "aws_s3_account_public_access_block",
 		},
+		{
+			Factory:  resourceAccessGrant,
+			TypeName: "aws_s3control_access_grant",
+			Name:     "Access Grant",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  resourceAccessGrantsInstance,
+			TypeName: "aws_s3control_access_grants_instance",
+			Name:     "Access Grants Instance",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  resourceAccessGrantsLocation,
+			TypeName: "aws_s3control_access_grants_location",
+			Name:     "Access Grants Location",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  resourceAccessPointPolicy,
 			TypeName: "aws_s3control_access_point_policy",
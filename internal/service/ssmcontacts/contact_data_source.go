@@ -64,7 +64,7 @@ func dataSourceContactRead(ctx context.Context, d *schema.ResourceData, meta int
 		return create.DiagError(names.SSMContacts, create.ErrActionReading, DSNameContact, d.Id(), err)
 	}
 
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
@@ -36,6 +36,46 @@ type DefaultConfig struct {
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
+	Scopes      []*IgnoreScope
+
+	serviceName string // Service package whose Scopes apply. Set via ForService.
+}
+
+// IgnoreScope contains various options for removing resource tags of specific services only.
+type IgnoreScope struct {
+	Services    []string // Service package names, e.g. "ec2"
+	Keys        KeyValueTags
+	KeyPrefixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
+}
+
+// ForService returns a copy of the configuration which additionally removes
+// any resource tags scoped to the specified service package.
+func (c *IgnoreConfig) ForService(serviceName string) *IgnoreConfig {
+	if c == nil || len(c.Scopes) == 0 {
+		return c
+	}
+
+	v := *c
+	v.serviceName = serviceName
+
+	return &v
+}
+
+// appliesTo returns whether the scope applies to the specified service package.
+func (s *IgnoreScope) appliesTo(serviceName string) bool {
+	if s == nil || serviceName == "" {
+		return false
+	}
+
+	for _, v := range s.Services {
+		if v == serviceName {
+			return true
+		}
+	}
+
+	return false
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -104,6 +144,17 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreRegexes(config.KeyRegexes)
+
+	for _, scope := range config.Scopes {
+		if !scope.appliesTo(config.serviceName) {
+			continue
+		}
+
+		result = result.IgnorePrefixes(scope.KeyPrefixes)
+		result = result.Ignore(scope.Keys)
+		result = result.IgnoreRegexes(scope.KeyRegexes)
+	}
 
 	return result
 }
@@ -155,6 +206,30 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreRegexes returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagRegex := range ignoreTagRegexes {
+			if ignoreTagRegex.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
@@ -2,6 +2,7 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -565,6 +566,85 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes",
+			tags: New(ctx, map[string]string{
+				"cost-0d6c9d9e-3a5b-4f4f-9a0e-0a1b2c3d4e5f": "value1",
+				"cost-center": "value2",
+				"key3":        "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^cost-[0-9a-f-]{36}$`),
+				},
+			},
+			want: map[string]string{
+				"cost-center": "value2",
+				"key3":        "value3",
+			},
+		},
+		{
+			name: "scopes no service",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key2":                       "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Scopes: []*IgnoreScope{
+					{
+						Services:    []string{"ec2", "eks"},
+						KeyPrefixes: New(ctx, []string{"kubernetes.io/cluster/"}),
+					},
+				},
+			},
+			want: map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key2":                       "value2",
+			},
+		},
+		{
+			name: "scopes matching service",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key2":                       "value2",
+				"key3":                       "value3",
+			}),
+			ignoreConfig: (&IgnoreConfig{
+				Keys: New(ctx, []string{"key3"}),
+				Scopes: []*IgnoreScope{
+					{
+						Services:    []string{"ec2", "eks"},
+						KeyPrefixes: New(ctx, []string{"kubernetes.io/cluster/"}),
+					},
+					{
+						Services: []string{"s3"},
+						Keys:     New(ctx, []string{"key2"}),
+					},
+				},
+			}).ForService("ec2"),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "scopes other service",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key2":                       "value2",
+			}),
+			ignoreConfig: (&IgnoreConfig{
+				Scopes: []*IgnoreScope{
+					{
+						Services:   []string{"ec2"},
+						KeyRegexes: []*regexp.Regexp{regexp.MustCompile(`^kubernetes\.io/`)},
+					},
+				},
+			}).ForService("s3"),
+			want: map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key2":                       "value2",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
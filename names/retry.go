@@ -0,0 +1,75 @@
+package names
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// RetryableError describes an AWS API error that is retried by all AWS API clients
+// of a service package, typically because it indicates eventual consistency
+// (e.g. IAM role propagation) rather than a permanent failure.
+type RetryableError struct {
+	ErrorCode    string // AWS API error code, e.g. "InvalidParameterValueException"
+	ErrorMessage string // Optional. If non-empty, the AWS API error message must contain this value.
+}
+
+const (
+	retryColProviderPackage = 0
+	retryColErrorCode       = 1
+	retryColErrorMessage    = 2
+	retryColNote            = 3
+)
+
+// retryableErrors key is the AWS provider service package
+var retryableErrors map[string][]RetryableError
+
+func init() {
+	retryableErrors = make(map[string][]RetryableError)
+
+	// Data from retry_data.csv
+	if err := readCSVIntoRetryableErrors(); err != nil {
+		log.Fatalf("reading CSV into retryable errors: %s", err)
+	}
+}
+
+//go:embed retry_data.csv
+var retryData string
+
+func readCSVIntoRetryableErrors() error {
+	r := csv.NewReader(strings.NewReader(retryData))
+
+	d, err := r.ReadAll()
+	if err != nil {
+		return fmt.Errorf("reading CSV into retryable errors: %w", err)
+	}
+
+	for i, l := range d {
+		if i < 1 { // omit header line
+			continue
+		}
+
+		p := l[retryColProviderPackage]
+		if p == "" {
+			return fmt.Errorf("line %d: empty provider package", i+1)
+		}
+
+		if l[retryColErrorCode] == "" {
+			return fmt.Errorf("line %d (%s): empty error code", i+1, p)
+		}
+
+		retryableErrors[p] = append(retryableErrors[p], RetryableError{
+			ErrorCode:    l[retryColErrorCode],
+			ErrorMessage: l[retryColErrorMessage],
+		})
+	}
+
+	return nil
+}
+
+// RetryableErrors returns the AWS API errors that are always retried for the specified service package.
+func RetryableErrors(providerPackage string) []RetryableError {
+	return retryableErrors[providerPackage]
+}
@@ -0,0 +1,54 @@
+package names
+
+import (
+	"testing"
+)
+
+func TestRetryableErrorsProviderPackages(t *testing.T) {
+	t.Parallel()
+
+	for p := range retryableErrors {
+		if _, ok := serviceData[p]; !ok {
+			t.Errorf("retryable errors defined for unknown provider package: %s", p)
+		}
+	}
+}
+
+func TestRetryableErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			TestName: "no rules",
+			Input:    Account,
+			Expected: false,
+		},
+		{
+			TestName: "rules",
+			Input:    Lambda,
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got := RetryableErrors(testCase.Input)
+
+			if (len(got) > 0) != testCase.Expected {
+				t.Errorf("got %d retryable errors, expected any: %t", len(got), testCase.Expected)
+			}
+		})
+	}
+}